@@ -0,0 +1,309 @@
+package alicloud
+
+import (
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// The lookups of the same kind which are made by the concurrent refreshes within describeBatchWindow are sent in one
+// Describe call, and their results are cached for describeCacheTTL.
+const (
+	describeBatchWindow = 20 * time.Millisecond
+	describeCacheTTL    = time.Minute
+)
+
+// describeKind is a kind of resource whose lookups can be batched. describe returns the found resources by their IDs,
+// and the missing ones are not found.
+type describeKind struct {
+	name         string
+	maxBatchSize int
+	describe     func(client *connectivity.AliyunClient, ids []string) (map[string]interface{}, error)
+}
+
+// refreshingIds are the IDs of the resources being refreshed by Terraform. Only their lookups are batched and cached,
+// so the lookups after creating or updating a resource and the polls of the state waiters always get fresh results.
+var refreshingIds = struct {
+	sync.Mutex
+	ids map[string]int
+}{ids: make(map[string]int)}
+
+func isRefreshing(id string) bool {
+	refreshingIds.Lock()
+	defer refreshingIds.Unlock()
+	return refreshingIds.ids[id] > 0
+}
+
+// addBatchedRefresh marks the resource as refreshing while Terraform reads it, when batch_describe is enabled. Read is
+// called by Terraform for refreshing only, because the operations which change a resource read it by themselves.
+func addBatchedRefresh(r *schema.Resource) {
+	read := r.Read
+	if read == nil {
+		return
+	}
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		client, ok := meta.(*connectivity.AliyunClient)
+		id := d.Id()
+		if !ok || client == nil || !client.BatchDescribe || id == "" {
+			return read(d, meta)
+		}
+		refreshingIds.Lock()
+		refreshingIds.ids[id]++
+		refreshingIds.Unlock()
+		defer func() {
+			refreshingIds.Lock()
+			if refreshingIds.ids[id]--; refreshingIds.ids[id] <= 0 {
+				delete(refreshingIds.ids, id)
+			}
+			refreshingIds.Unlock()
+		}()
+		return read(d, meta)
+	}
+}
+
+// batchDescribe looks up the resource in a batched Describe call of its kind. ok is false when the lookup is not
+// batched, and then the caller describes the resource alone. The callers also describe the resources which are not
+// found alone, so that they return the same errors as before.
+func batchDescribe(client *connectivity.AliyunClient, kind describeKind, id string) (object interface{}, found bool, ok bool) {
+	if !client.BatchDescribe || id == "" {
+		return nil, false, false
+	}
+	batcher := client.RegionValue("describe_batcher:"+kind.name, func() interface{} {
+		return &describeBatcher{kind: kind, client: client, batches: make(map[string]*describeBatch)}
+	}).(*describeBatcher)
+	if !isRefreshing(id) {
+		batcher.forget(id)
+		return nil, false, false
+	}
+	object, found, err := batcher.load(id)
+	if err != nil {
+		// A batch fails as a whole, like when one of its IDs is malformed, so the lookups are retried alone.
+		log.Printf("[DEBUG] The batched lookup of %s %s got an error, and it is described alone: %s", kind.name, id, err)
+		return nil, false, false
+	}
+	return object, found, true
+}
+
+// describeBatcher coalesces the lookups of a kind in a region. It is safe for concurrent use.
+type describeBatcher struct {
+	kind   describeKind
+	client *connectivity.AliyunClient
+
+	mutex   sync.Mutex
+	pending *describeBatch
+	// batches are the last batches which looked up the IDs.
+	batches map[string]*describeBatch
+}
+
+type describeBatch struct {
+	ids       []string
+	once      sync.Once
+	done      chan struct{}
+	objects   map[string]interface{}
+	err       error
+	fetchedAt time.Time
+}
+
+// expired reports whether the batch is finished and its results cannot be used any more.
+func (batch *describeBatch) expired() bool {
+	select {
+	case <-batch.done:
+		return batch.err != nil || time.Since(batch.fetchedAt) > describeCacheTTL
+	default:
+		return false
+	}
+}
+
+func (b *describeBatcher) load(id string) (interface{}, bool, error) {
+	b.mutex.Lock()
+	batch, ok := b.batches[id]
+	if !ok || batch.expired() {
+		batch = b.pending
+		if batch == nil {
+			batch = &describeBatch{done: make(chan struct{})}
+			b.pending = batch
+			time.AfterFunc(describeBatchWindow, func() { b.dispatch(batch) })
+		}
+		batch.ids = append(batch.ids, id)
+		b.batches[id] = batch
+		if len(batch.ids) >= b.kind.maxBatchSize {
+			b.pending = nil
+			go b.dispatch(batch)
+		}
+	}
+	b.mutex.Unlock()
+
+	<-batch.done
+	if batch.err != nil {
+		return nil, false, batch.err
+	}
+	object, found := batch.objects[id]
+	return object, found, nil
+}
+
+// dispatch sends the Describe call of the batch once, when it is full or its window is over.
+func (b *describeBatcher) dispatch(batch *describeBatch) {
+	batch.once.Do(func() {
+		b.mutex.Lock()
+		if b.pending == batch {
+			b.pending = nil
+		}
+		b.mutex.Unlock()
+		batch.objects, batch.err = b.kind.describe(b.client, batch.ids)
+		batch.fetchedAt = time.Now()
+		close(batch.done)
+	})
+}
+
+// forget drops the cached result of the ID, which is looked up alone after it is changed.
+func (b *describeBatcher) forget(id string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if batch, ok := b.batches[id]; ok && batch != b.pending {
+		select {
+		case <-batch.done:
+			delete(b.batches, id)
+		default:
+		}
+	}
+}
+
+func jsonIdList(ids []string) string {
+	list := make([]interface{}, len(ids))
+	for i, id := range ids {
+		list[i] = id
+	}
+	return convertListToJsonString(list)
+}
+
+var ecsInstanceDescribeKind = describeKind{
+	name:         "instance",
+	maxBatchSize: PageSizeXLarge,
+	describe: func(client *connectivity.AliyunClient, ids []string) (map[string]interface{}, error) {
+		request := ecs.CreateDescribeInstancesRequest()
+		request.InstanceIds = jsonIdList(ids)
+		request.PageSize = requests.NewInteger(PageSizeXLarge)
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeInstances(request)
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, strings.Join(ids, ","), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw)
+		response, _ := raw.(*ecs.DescribeInstancesResponse)
+		objects := make(map[string]interface{})
+		for _, instance := range response.Instances.Instance {
+			objects[instance.InstanceId] = instance
+		}
+		return objects, nil
+	},
+}
+
+var ecsDiskDescribeKind = describeKind{
+	name:         "disk",
+	maxBatchSize: PageSizeXLarge,
+	describe: func(client *connectivity.AliyunClient, ids []string) (map[string]interface{}, error) {
+		request := ecs.CreateDescribeDisksRequest()
+		request.DiskIds = jsonIdList(ids)
+		request.PageSize = requests.NewInteger(PageSizeXLarge)
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeDisks(request)
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, strings.Join(ids, ","), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw)
+		response, _ := raw.(*ecs.DescribeDisksResponse)
+		objects := make(map[string]interface{})
+		for _, disk := range response.Disks.Disk {
+			objects[disk.DiskId] = disk
+		}
+		return objects, nil
+	},
+}
+
+// DescribeDisks filters the disks by one instance only, so the system disks of the instances are picked out of the
+// system disks of the region. It reads no more pages than there are instances, and the instances whose system disks
+// are not on these pages are looked up alone.
+var ecsSystemDiskDescribeKind = describeKind{
+	name:         "system_disk",
+	maxBatchSize: PageSizeXLarge,
+	describe: func(client *connectivity.AliyunClient, ids []string) (map[string]interface{}, error) {
+		instanceIds := make(map[string]bool)
+		for _, id := range ids {
+			instanceIds[id] = true
+		}
+		request := ecs.CreateDescribeDisksRequest()
+		request.DiskType = string(DiskTypeSystem)
+		request.PageSize = requests.NewInteger(PageSizeXLarge)
+		objects := make(map[string]interface{})
+		for page := 1; page <= len(ids) && len(objects) < len(ids); page++ {
+			request.PageNumber = requests.NewInteger(page)
+			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+				return ecsClient.DescribeDisks(request)
+			})
+			if err != nil {
+				return nil, WrapErrorf(err, DefaultErrorMsg, strings.Join(ids, ","), request.GetActionName(), AlibabaCloudSdkGoERROR)
+			}
+			addDebug(request.GetActionName(), raw)
+			response, _ := raw.(*ecs.DescribeDisksResponse)
+			for _, disk := range response.Disks.Disk {
+				if instanceIds[disk.InstanceId] {
+					objects[disk.InstanceId] = disk
+				}
+			}
+			if len(response.Disks.Disk) < PageSizeXLarge {
+				break
+			}
+		}
+		return objects, nil
+	},
+}
+
+// DescribeVpcs accepts up to 20 IDs, and its results are converted to the attributes of the VPCs, without the
+// attributes which it does not return, like the associated CENs.
+var vpcDescribeKind = describeKind{
+	name:         "vpc",
+	maxBatchSize: 20,
+	describe: func(client *connectivity.AliyunClient, ids []string) (map[string]interface{}, error) {
+		request := vpc.CreateDescribeVpcsRequest()
+		request.RegionId = client.RegionId
+		request.VpcId = strings.Join(ids, ",")
+		request.PageSize = requests.NewInteger(PageSizeLarge)
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeVpcs(request)
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, request.VpcId, request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw)
+		response, _ := raw.(*vpc.DescribeVpcsResponse)
+		objects := make(map[string]interface{})
+		for _, v := range response.Vpcs.Vpc {
+			objects[v.VpcId] = vpc.DescribeVpcAttributeResponse{
+				VpcId:           v.VpcId,
+				RegionId:        v.RegionId,
+				Status:          v.Status,
+				VpcName:         v.VpcName,
+				CreationTime:    v.CreationTime,
+				CidrBlock:       v.CidrBlock,
+				Ipv6CidrBlock:   v.Ipv6CidrBlock,
+				VRouterId:       v.VRouterId,
+				Description:     v.Description,
+				IsDefault:       v.IsDefault,
+				ResourceGroupId: v.ResourceGroupId,
+				NetworkAclNum:   v.NetworkAclNum,
+				VSwitchIds:      vpc.VSwitchIdsInDescribeVpcAttribute{VSwitchId: v.VSwitchIds.VSwitchId},
+				UserCidrs:       vpc.UserCidrsInDescribeVpcAttribute{UserCidr: v.UserCidrs.UserCidr},
+			}
+		}
+		return objects, nil
+	},
+}
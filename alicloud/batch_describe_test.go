@@ -0,0 +1,104 @@
+package alicloud
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestDescribeBatcher(t *testing.T) {
+	var mutex sync.Mutex
+	var calls [][]string
+	batcher := &describeBatcher{
+		kind: describeKind{name: "test", maxBatchSize: 3, describe: func(client *connectivity.AliyunClient, ids []string) (map[string]interface{}, error) {
+			mutex.Lock()
+			calls = append(calls, ids)
+			mutex.Unlock()
+			objects := make(map[string]interface{})
+			for _, id := range ids {
+				if id == "malformed" {
+					return nil, fmt.Errorf("invalid id %s", id)
+				}
+				if id != "missing" {
+					objects[id] = "object of " + id
+				}
+			}
+			return objects, nil
+		}},
+		batches: make(map[string]*describeBatch),
+	}
+
+	load := func(ids ...string) []error {
+		errs := make([]error, len(ids))
+		var wg sync.WaitGroup
+		for i, id := range ids {
+			wg.Add(1)
+			go func(i int, id string) {
+				defer wg.Done()
+				object, found, err := batcher.load(id)
+				if err == nil && found != (id != "missing") || found && object != "object of "+id {
+					err = fmt.Errorf("unexpected result %v, %v of %s", object, found, id)
+				}
+				errs[i] = err
+			}(i, id)
+		}
+		wg.Wait()
+		return errs
+	}
+
+	for _, err := range load("i-1", "i-2", "i-2", "missing", "i-3") {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(calls) != 2 || len(calls[0])+len(calls[1]) != 4 {
+		t.Fatalf("the lookups are expected to be sent in 2 calls of at most 3 IDs, got %v", calls)
+	}
+
+	load("i-1", "missing")
+	if len(calls) != 2 {
+		t.Fatalf("the cached results are expected to be used, got the calls %v", calls)
+	}
+	batcher.forget("i-1")
+	load("i-1")
+	if len(calls) != 3 {
+		t.Fatalf("the forgotten result is expected to be looked up again, got the calls %v", calls)
+	}
+
+	if errs := load("malformed"); errs[0] == nil {
+		t.Fatalf("the error of the batch is expected to be returned")
+	}
+	load("malformed")
+	if len(calls) != 5 {
+		t.Fatalf("the failed batch is expected not to be cached, got the calls %v", calls)
+	}
+}
+
+func TestAddBatchedRefresh(t *testing.T) {
+	var refreshing bool
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			refreshing = isRefreshing(d.Id())
+			return nil
+		},
+	}
+	addBatchedRefresh(r)
+	d := r.TestResourceData()
+	d.SetId("i-1")
+
+	for _, enabled := range []bool{false, true} {
+		if err := r.Read(d, &connectivity.AliyunClient{BatchDescribe: enabled}); err != nil {
+			t.Fatal(err)
+		}
+		if refreshing != enabled {
+			t.Errorf("expected refreshing to be %t when batch_describe is %t", enabled, enabled)
+		}
+	}
+	if isRefreshing("i-1") {
+		t.Errorf("the resource is expected not to be refreshing after it is read")
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 
 	"gopkg.in/yaml.v2"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/denverdino/aliyungo/common"
 	"github.com/google/uuid"
 	"github.com/mitchellh/go-homedir"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
 type InstanceNetWork string
@@ -178,6 +180,18 @@ const (
 	SnapshotPolicyCreating  = Status("Creating")
 	SnapshotPolicyAvaliable = Status("avaliable")
 	SnapshotPolicyNormal    = Status("Normal")
+
+	ImageCreating     = Status("Creating")
+	ImageWaiting      = Status("Waiting")
+	ImageAvailable    = Status("Available")
+	ImageUnAvailable  = Status("UnAvailable")
+	ImageCreateFailed = Status("CreateFailed")
+
+	TaskWaiting    = Status("Waiting")
+	TaskProcessing = Status("Processing")
+	TaskFinished   = Status("Finished")
+	TaskFailed     = Status("Failed")
+	TaskDeleted    = Status("Deleted")
 )
 
 // timeout for common product, ecs e.g.
@@ -334,6 +348,14 @@ const (
 	TagResourceDisk          = TagResourceType("disk")
 	TagResourceSecurityGroup = TagResourceType("securitygroup")
 	TagResourceEni           = TagResourceType("eni")
+
+	// The resource types of TagResources, UntagResources and ListTagResources
+	TagResourceVpc             = TagResourceType("VPC")
+	TagResourceVSwitch         = TagResourceType("VSWITCH")
+	TagResourceEip             = TagResourceType("EIP")
+	TagResourceNatGateway      = TagResourceType("NATGATEWAY")
+	TagResourceKVStoreInstance = TagResourceType("INSTANCE")
+	TagResourceMongoDBInstance = TagResourceType("INSTANCE")
 )
 
 type KubernetesNodeType string
@@ -421,10 +443,15 @@ func writeToFile(filePath string, data interface{}) error {
 	return nil
 }
 
+// Invoker retries a call on the errors of its catchers. The retry limits and the stop context are taken from the client,
+// and an Invoker without a client is only limited by its catchers.
 type Invoker struct {
+	client   *connectivity.AliyunClient
 	catchers []*Catcher
 }
 
+// Catcher is the retry policy of one error category: the error code or the ErrorCategory name, the max retry count and
+// the base delay of its exponential backoff.
 type Catcher struct {
 	Reason           string
 	RetryCount       int
@@ -432,11 +459,18 @@ type Catcher struct {
 }
 
 var ClientErrorCatcher = Catcher{AliyunGoClientFailure, 10, 5}
-var ServiceBusyCatcher = Catcher{"ServiceUnavailable", 10, 5}
-var ThrottlingCatcher = Catcher{Throttling, 10, 10}
+var ServiceBusyCatcher = Catcher{string(ErrorRetryable), 10, 5}
+var ThrottlingCatcher = Catcher{string(ErrorThrottled), 10, 10}
+
+// AmbiguousFailure is the Catcher reason of the errors for which IsAmbiguousError is true.
+const AmbiguousFailure = "AmbiguousFailure"
 
-func NewInvoker() Invoker {
-	i := Invoker{}
+// ClientTokenCatcher resends the requests which carry a client token after the ambiguous failures. It must not be
+// used for the requests without a token, which may take effect twice.
+var ClientTokenCatcher = Catcher{AmbiguousFailure, 5, 5}
+
+func NewInvoker(client *connectivity.AliyunClient) Invoker {
+	i := Invoker{client: client}
 	i.AddCatcher(ClientErrorCatcher)
 	i.AddCatcher(ServiceBusyCatcher)
 	i.AddCatcher(ThrottlingCatcher)
@@ -448,25 +482,52 @@ func (a *Invoker) AddCatcher(catcher Catcher) {
 }
 
 func (a *Invoker) Run(f func() error) error {
-	err := f()
-
-	if err == nil {
-		return nil
-	}
+	engine := a.client.RetryEngine()
+	start := time.Now()
+	attempts := make(map[*Catcher]int)
+	for {
+		err := f()
+		if err == nil {
+			engine.Succeed()
+			return nil
+		}
 
-	for _, catcher := range a.catchers {
-		if IsExceptedErrors(err, []string{catcher.Reason}) {
-			catcher.RetryCount--
+		var catcher *Catcher
+		for _, c := range a.catchers {
+			if c.catches(err) {
+				catcher = c
+				break
+			}
+		}
+		if catcher == nil {
+			return err
+		}
 
-			if catcher.RetryCount <= 0 {
+		attempts[catcher]++
+		delay := engine.Backoff(time.Duration(catcher.RetryWaitSeconds)*time.Second, attempts[catcher])
+		if isTransientError(err) {
+			if !engine.Allow(catcher.RetryCount, attempts[catcher], start, delay) {
 				return fmt.Errorf("Retry timeout and got an error: %#v.", err)
-			} else {
-				time.Sleep(time.Duration(catcher.RetryWaitSeconds) * time.Second)
-				return a.Run(f)
 			}
+		} else if attempts[catcher] > catcher.RetryCount {
+			return fmt.Errorf("Retry timeout and got an error: %#v.", err)
+		}
+		if err := sleepContext(a.client, delay); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Catcher) catches(err error) bool {
+	if c.Reason == AmbiguousFailure {
+		return IsAmbiguousError(err)
+	}
+	for _, category := range errorCategories {
+		if c.Reason == string(category) {
+			return IsErrorCategory(err, category)
 		}
 	}
-	return err
+	return IsExceptedErrors(err, []string{c.Reason})
 }
 
 func buildClientToken(action string) string {
@@ -477,6 +538,69 @@ func buildClientToken(action string) string {
 	return token
 }
 
+// createLookup finds the resource created by an earlier attempt of a create request, and returns the response which
+// the request would have got, or nil when none is found. It must only find the resource of this request, like by a
+// name which is unique in the region, so that a resource created by another request is never taken for it.
+type createLookup func() (interface{}, error)
+
+// createWithClientToken calls create, which sends a create request carrying a client token, and resends the request
+// after the throttling and the ambiguous failures, like a timeout. The request is built once, so that all of the
+// attempts carry the same token, and the server returns the resource created by an earlier attempt instead of creating
+// another one. When the request still fails ambiguously after the resends, lookup finds the resource which may have
+// been created. It is nil for the resources which can not be told apart from the others without their ID.
+// createWithClientToken must not be called in another retry loop, which would resend the request once more for each
+// of its retries, and such a loop waits with clientTokenWait instead.
+func createWithClientToken(client *connectivity.AliyunClient, create func() (interface{}, error), lookup createLookup) (interface{}, error) {
+	var raw interface{}
+	var lastErr error
+	invoker := Invoker{client: client}
+	invoker.AddCatcher(ThrottlingCatcher)
+	invoker.AddCatcher(ClientTokenCatcher)
+	err := invoker.Run(func() error {
+		response, err := create()
+		if err != nil {
+			lastErr = err
+			return err
+		}
+		raw = response
+		return nil
+	})
+	if err == nil || lookup == nil || !IsAmbiguousError(lastErr) {
+		return raw, err
+	}
+	found, e := lookup()
+	if e != nil {
+		log.Printf("[WARN] Looking up the resource after an ambiguous failure got an error: %s", e)
+		return raw, err
+	}
+	if found == nil {
+		return raw, err
+	}
+	log.Printf("[WARN] The create request failed ambiguously, and the resource created by it is found: %s", lastErr)
+	return found, nil
+}
+
+// clientTokenWait returns a function to be called before each retry of a resource.Retry loop which sends a create
+// request carrying a client token. It reports whether the request should be resent after the error, which is only the
+// case for the ambiguous failures, and waits like ClientTokenCatcher before. The retries are charged against the retry
+// budget like incrementalWait.
+func clientTokenWait(client *connectivity.AliyunClient) func(err error) bool {
+	engine := client.RetryEngine()
+	attempts := 0
+	start := time.Now()
+	return func(err error) bool {
+		if !IsAmbiguousError(err) {
+			return false
+		}
+		attempts++
+		delay := engine.Backoff(time.Duration(ClientTokenCatcher.RetryWaitSeconds)*time.Second, attempts)
+		if !engine.Allow(ClientTokenCatcher.RetryCount, attempts, start, delay) {
+			return false
+		}
+		return sleepContext(client, delay) == nil
+	}
+}
+
 func getNextpageNumber(number requests.Integer) (requests.Integer, error) {
 	page, err := strconv.Atoi(string(number))
 	if err != nil {
@@ -567,6 +691,8 @@ func debugOn() bool {
 	return false
 }
 
+// addDebug logs the content with its callers when DEBUG=terraform is set. The values of the sensitive fields are
+// redacted, and it is written to the log only, because the standard output of the provider may be kept in CI logs.
 func addDebug(action, content interface{}) {
 	if debugOn() {
 		trace := "[DEBUG TRACE]:\n"
@@ -575,8 +701,11 @@ func addDebug(action, content interface{}) {
 			trace += fmt.Sprintf("%s:%d\n", filepath, line)
 		}
 
-		fmt.Printf(DefaultDebugMsg, action, content, trace)
-		log.Printf(DefaultDebugMsg, action, content, trace)
+		data, err := json.Marshal(connectivity.Redact(content))
+		if err != nil {
+			data = []byte(fmt.Sprintf("<%T which cannot be traced>", content))
+		}
+		log.Printf(DefaultDebugMsg, action, data, trace)
 	}
 }
 
@@ -609,11 +738,11 @@ func GetCenChildInstanceType(id string) (c string, e error) {
 	}
 }
 
-func BuildStateConf(pending, target []string, timeout, delay time.Duration, f resource.StateRefreshFunc) *resource.StateChangeConf {
+func BuildStateConf(client *connectivity.AliyunClient, pending, target []string, timeout, delay time.Duration, f resource.StateRefreshFunc) *resource.StateChangeConf {
 	return &resource.StateChangeConf{
 		Pending:    pending,
 		Target:     target,
-		Refresh:    f,
+		Refresh:    retryRefreshFunc(client, f),
 		Timeout:    timeout,
 		Delay:      delay,
 		MinTimeout: 3 * time.Second,
@@ -628,16 +757,53 @@ const (
 	EventAll   = EventRwType("All")
 )
 
-func incrementalWait(firstDuration time.Duration, increaseDuration time.Duration) func() {
+// incrementalWait returns a function to be called before each retry of a resource.Retry loop on a throttled or
+// transient error, and every retry is charged against the retry budget. It sleeps firstDuration
+// at first and then an exponential backoff with jitter based on increaseDuration. It returns false without sleeping
+// once the provider retry limits are reached or the provider is stopped, and the caller should stop retrying.
+func incrementalWait(client *connectivity.AliyunClient, firstDuration time.Duration, increaseDuration time.Duration) func() bool {
+	engine := client.RetryEngine()
 	retryCount := 1
-	return func() {
+	start := time.Now()
+	return func() bool {
 		var waitTime time.Duration
 		if retryCount == 1 {
 			waitTime = firstDuration
 		} else if retryCount > 1 {
-			waitTime = time.Duration(retryCount-1) * increaseDuration
+			waitTime = engine.Backoff(increaseDuration, retryCount-1)
+		}
+		if !engine.Allow(INT_MAX, retryCount, start, waitTime) {
+			return false
+		}
+		if sleepContext(client, waitTime) != nil {
+			return false
 		}
-		time.Sleep(waitTime)
 		retryCount++
+		return true
+	}
+}
+
+// dependencyWait returns a function to be called before each retry of a resource.Retry loop which waits for a
+// dependency, like a resource in a conflicting status. It sleeps the interval and returns false only when the provider
+// is stopped, so the loop is bounded by its own timeout and not by the provider retry limits.
+func dependencyWait(client *connectivity.AliyunClient, interval time.Duration) func() bool {
+	return func() bool {
+		return sleepContext(client, interval) == nil
+	}
+}
+
+// timeoutSeconds returns the timeout of an operation of the resource in seconds, which is taken by the service waiters.
+func timeoutSeconds(d *schema.ResourceData, key string) int {
+	return int(d.Timeout(key).Seconds())
+}
+
+// importStateParseResourceId returns an importer which passes through the composite ID of a resource after checking it
+// has the expected number of parts, so that a malformed ID is rejected at import instead of by the following read.
+func importStateParseResourceId(length int) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if _, err := ParseResourceId(d.Id(), length); err != nil {
+			return nil, WrapError(err)
+		}
+		return []*schema.ResourceData{d}, nil
 	}
 }
@@ -2,6 +2,7 @@ package connectivity
 
 import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/endpoints"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/actiontrail"
@@ -54,6 +55,17 @@ import (
 	"time"
 )
 
+// regionClientCache holds the clients of the regions other than the provider region, keyed by the region ID.
+type regionClientCache struct {
+	mutex   sync.Mutex
+	clients map[string]*AliyunClient
+}
+
+type regionValueCache struct {
+	mutex  sync.Mutex
+	values map[string]interface{}
+}
+
 // AliyunClient of aliyun
 type AliyunClient struct {
 	Region   Region
@@ -63,6 +75,10 @@ type AliyunClient struct {
 	SecretKey                    string
 	SecurityToken                string
 	OtsInstanceName              string
+	DefaultTags                  map[string]string
+	IgnoreTagKeys                []string
+	IgnoreTagKeyPrefixes         []string
+	BatchDescribe                bool
 	accountIdMutex               sync.RWMutex
 	config                       *Config
 	accountId                    string
@@ -102,6 +118,21 @@ type AliyunClient struct {
 	casconn                      *cas.Client
 	ddoscooconn                  *ddoscoo.Client
 	bssopenapiconn               *bssopenapi.Client
+	recorder                     *recorder
+	tracer                       *tracer
+	metrics                      *apiMetrics
+	resourceType                 string
+	resourceClients              *regionClientCache
+	regionValues                 *regionValueCache
+	rateLimiter                  *rateLimiter
+	retryEngine                  *RetryEngine
+	credential                   *credentialCache
+	regionClients                *regionClientCache
+	regionIds                    *regionIdCache
+	// The credential used by the clients now, and its copies shared by the SDK clients
+	authCredential      *Credential
+	stsCredential       *credentials.StsTokenCredential
+	accessKeyCredential *credentials.AccessKeyCredential
 }
 
 type ApiVersion string
@@ -110,6 +141,9 @@ const (
 	ApiVersion20140526 = ApiVersion("2014-05-26")
 	ApiVersion20160815 = ApiVersion("2016-08-15")
 	ApiVersion20140515 = ApiVersion("2014-05-15")
+	ApiVersion20160428 = ApiVersion("2016-04-28")
+	ApiVersion20150101 = ApiVersion("2015-01-01")
+	ApiVersion20151201 = ApiVersion("2015-12-01")
 )
 
 const businessInfoKey = "Terraform"
@@ -124,15 +158,74 @@ var version = strings.TrimSuffix(terraform.VersionString(), "-dev")
 
 // Client for AliyunClient
 func (c *Config) Client() (*AliyunClient, error) {
-	// Get the auth and region. This can fail if keys/regions were not
+	if c.RecordMode == RecordModeReplay && c.AccessKey == "" && c.SecretKey == "" {
+		// The replayed requests are never sent, so any credential signs them
+		c.AccessKey, c.SecretKey = "replay", "replay"
+	}
+	client := c.newClient()
+	client.rateLimiter = newRateLimiter(c.RateLimits, client.StopContext())
+	client.retryEngine = NewRetryEngine(c.MaxRetries, c.MaxRetryTimeout, DefaultRetryBudget)
+	client.regionClients = &regionClientCache{clients: make(map[string]*AliyunClient)}
+	client.regionIds = newRegionIdCache(c.RegionCacheFile)
+	client.credential = newCredentialCache(c.credentialProvider(client))
+
+	if c.RecordMode != RecordModeNone {
+		recorder, err := newRecorder(c.RecordMode, c.CassettePath, client.getHttpProxyUrl())
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("[INFO] API exchanges are in %s mode with cassette %s", c.RecordMode, c.CassettePath)
+		client.recorder = recorder
+	}
+	tracer, err := newTracer(c.TraceFile, c.TraceFullBody)
+	if err != nil {
+		return nil, err
+	}
+	client.tracer = tracer
+	if c.MetricsReportFile != "" {
+		metrics, err := newApiMetrics(c.MetricsReportFile, c.MetricsReportFormat)
+		if err != nil {
+			return nil, err
+		}
+		metrics.flushOnStop(client.StopContext())
+		client.metrics = metrics
+		client.resourceClients = &regionClientCache{clients: make(map[string]*AliyunClient)}
+	}
+
+	// Validate the region with the regions discovered by the API, which fails if keys/regions were not
 	// specified and we're attempting to use the environment.
 	if !c.SkipRegionValidation {
-		err := c.loadAndValidate()
+		if err := client.validateRegionId(c.RegionId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Fail fast before any resource is touched when the credential is invalid or belongs to an unexpected account.
+	if !c.SkipCredentialsValidation {
+		identity, err := client.getCallerIdentity()
 		if err != nil {
+			return nil, fmt.Errorf("Validating the credential got an error: %s. Set skip_credentials_validation to skip it.", err)
+		}
+		if err := c.validateAccountId(identity.AccountId); err != nil {
+			return nil, err
+		}
+		if client.accountId == "" {
+			client.accountId = identity.AccountId
+		}
+	} else if len(c.AllowedAccountIds) > 0 || len(c.ForbiddenAccountIds) > 0 {
+		if c.AccountId == "" {
+			return nil, fmt.Errorf("account_id is required to check allowed_account_ids and forbidden_account_ids when skip_credentials_validation is set.")
+		}
+		if err := c.validateAccountId(c.AccountId); err != nil {
 			return nil, err
 		}
 	}
 
+	return client, nil
+}
+
+// newClient builds a client without any credential, rate limiter or recorder, which are filled in by the caller.
+func (c *Config) newClient() *AliyunClient {
 	return &AliyunClient{
 		config:                       c,
 		Region:                       c.Region,
@@ -141,26 +234,89 @@ func (c *Config) Client() (*AliyunClient, error) {
 		SecretKey:                    c.SecretKey,
 		SecurityToken:                c.SecurityToken,
 		OtsInstanceName:              c.OtsInstanceName,
+		DefaultTags:                  c.DefaultTags,
+		IgnoreTagKeys:                c.IgnoreTagKeys,
+		IgnoreTagKeyPrefixes:         c.IgnoreTagKeyPrefixes,
+		BatchDescribe:                c.BatchDescribe,
 		accountId:                    c.AccountId,
 		tablestoreconnByInstanceName: make(map[string]*tablestore.TableStoreClient),
 		csprojectconnByKey:           make(map[string]*cs.ProjectClient),
-	}, nil
+		stsCredential:                credentials.NewStsTokenCredential("", "", ""),
+		accessKeyCredential:          credentials.NewAccessKeyCredential("", ""),
+		regionValues:                 &regionValueCache{values: make(map[string]interface{})},
+	}
+}
+
+// ForRegion returns the client of another region, which shares the credential, the rate limiter and the recorder
+// with this one. The clients are cached, so each region has only one client.
+func (client *AliyunClient) ForRegion(regionId string) (*AliyunClient, error) {
+	if regionId == "" || regionId == client.RegionId {
+		return client, nil
+	}
+	client.regionClients.mutex.Lock()
+	defer client.regionClients.mutex.Unlock()
+	if regional, ok := client.regionClients.clients[regionId]; ok {
+		return regional, nil
+	}
+
+	config := client.config.forRegion(regionId)
+	if !config.SkipRegionValidation {
+		if err := client.validateRegionId(regionId); err != nil {
+			return nil, err
+		}
+	}
+	regional := config.newClient()
+	client.share(regional)
+	client.regionClients.clients[regionId] = regional
+	log.Printf("[DEBUG] The client of region %s is created", regionId)
+	return regional, nil
+}
+
+// share makes the other client share the credential, the caches, the rate limiter, the retry engine, the recorder, the
+// tracer and the metrics with this one.
+func (client *AliyunClient) share(other *AliyunClient) {
+	other.credential = client.credential
+	other.rateLimiter = client.rateLimiter
+	other.retryEngine = client.retryEngine
+	other.recorder = client.recorder
+	other.tracer = client.tracer
+	other.metrics = client.metrics
+	other.resourceClients = client.resourceClients
+	other.regionClients = client.regionClients
+	other.regionIds = client.regionIds
+	client.accountIdMutex.RLock()
+	other.accountId = client.accountId
+	client.accountIdMutex.RUnlock()
+}
+
+// RegionValue returns the value of the key shared by the clients of the region, which is created by create on the
+// first call. The clients of the resource types share the values of their region.
+func (client *AliyunClient) RegionValue(key string, create func() interface{}) interface{} {
+	client.regionValues.mutex.Lock()
+	defer client.regionValues.mutex.Unlock()
+	if value, ok := client.regionValues.values[key]; ok {
+		return value
+	}
+	value := create()
+	client.regionValues.values[key] = value
+	return value
 }
 
 func (client *AliyunClient) WithEcsClient(do func(*ecs.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(ECSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the ECS client if necessary
 	if client.ecsconn == nil {
-		endpoint := client.config.EcsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ECSCode)
-		}
+		endpoint := client.config.resolveEndpoint(ECSCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(ECSCode), endpoint)
 		}
-		ecsconn, err := ecs.NewClientWithOptions(client.config.RegionId, client.getSdkConfig().WithTimeout(time.Duration(60)*time.Second), client.config.getAuthCredential(true))
+		ecsconn, err := ecs.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(ECSCode).WithTimeout(time.Duration(60)*time.Second), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the ECS client: %#v", err)
 		}
@@ -172,23 +328,26 @@ func (client *AliyunClient) WithEcsClient(do func(*ecs.Client) (interface{}, err
 		client.ecsconn = ecsconn
 	}
 
-	return do(client.ecsconn)
+	return client.invoke(ECSCode, func() (interface{}, error) {
+		return do(client.ecsconn)
+	})
 }
 
 func (client *AliyunClient) WithRdsClient(do func(*rds.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(RDSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the RDS client if necessary
 	if client.rdsconn == nil {
-		endpoint := client.config.RdsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, RDSCode)
-		}
+		endpoint := client.config.resolveEndpoint(RDSCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(RDSCode), endpoint)
 		}
-		rdsconn, err := rds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		rdsconn, err := rds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(RDSCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the RDS client: %#v", err)
 		}
@@ -197,23 +356,26 @@ func (client *AliyunClient) WithRdsClient(do func(*rds.Client) (interface{}, err
 		client.rdsconn = rdsconn
 	}
 
-	return do(client.rdsconn)
+	return client.invoke(RDSCode, func() (interface{}, error) {
+		return do(client.rdsconn)
+	})
 }
 
 func (client *AliyunClient) WithSlbClient(do func(*slb.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(SLBCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the SLB client if necessary
 	if client.slbconn == nil {
-		endpoint := client.config.SlbEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, SLBCode)
-		}
+		endpoint := client.config.resolveEndpoint(SLBCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(SLBCode), endpoint)
 		}
-		slbconn, err := slb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		slbconn, err := slb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(SLBCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the SLB client: %#v", err)
 		}
@@ -222,23 +384,26 @@ func (client *AliyunClient) WithSlbClient(do func(*slb.Client) (interface{}, err
 		client.slbconn = slbconn
 	}
 
-	return do(client.slbconn)
+	return client.invoke(SLBCode, func() (interface{}, error) {
+		return do(client.slbconn)
+	})
 }
 
 func (client *AliyunClient) WithVpcClient(do func(*vpc.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(VPCCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the VPC client if necessary
 	if client.vpcconn == nil {
-		endpoint := client.config.VpcEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, VPCCode)
-		}
+		endpoint := client.config.resolveEndpoint(VPCCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(VPCCode), endpoint)
 		}
-		vpcconn, err := vpc.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		vpcconn, err := vpc.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(VPCCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the VPC client: %#v", err)
 		}
@@ -247,20 +412,26 @@ func (client *AliyunClient) WithVpcClient(do func(*vpc.Client) (interface{}, err
 		client.vpcconn = vpcconn
 	}
 
-	return do(client.vpcconn)
+	return client.invoke(VPCCode, func() (interface{}, error) {
+		return do(client.vpcconn)
+	})
 }
 
 func (client *AliyunClient) WithNasClient(do func(*nas.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(NASCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the Nas client if necessary
 	if client.nasconn == nil {
-		endpoint := loadEndpoint(client.config.RegionId, NASCode)
+		endpoint := client.config.resolveEndpoint(NASCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(NASCode), endpoint)
 		}
-		nasconn, err := nas.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		nasconn, err := nas.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(NASCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the NAS client: %#v", err)
 		}
@@ -268,23 +439,26 @@ func (client *AliyunClient) WithNasClient(do func(*nas.Client) (interface{}, err
 		client.nasconn = nasconn
 	}
 
-	return do(client.nasconn)
+	return client.invoke(NASCode, func() (interface{}, error) {
+		return do(client.nasconn)
+	})
 }
 
 func (client *AliyunClient) WithCenClient(do func(*cbn.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(CENCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the CEN client if necessary
 	if client.cenconn == nil {
-		endpoint := client.config.CenEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CENCode)
-		}
+		endpoint := client.config.resolveEndpoint(CENCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(CENCode), endpoint)
 		}
-		cenconn, err := cbn.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		cenconn, err := cbn.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(CENCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the CEN client: %#v", err)
 		}
@@ -293,23 +467,26 @@ func (client *AliyunClient) WithCenClient(do func(*cbn.Client) (interface{}, err
 		client.cenconn = cenconn
 	}
 
-	return do(client.cenconn)
+	return client.invoke(CENCode, func() (interface{}, error) {
+		return do(client.cenconn)
+	})
 }
 
 func (client *AliyunClient) WithEssClient(do func(*ess.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(ESSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the ESS client if necessary
 	if client.essconn == nil {
-		endpoint := client.config.EssEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ESSCode)
-		}
+		endpoint := client.config.resolveEndpoint(ESSCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(ESSCode), endpoint)
 		}
-		essconn, err := ess.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		essconn, err := ess.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(ESSCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the ESS client: %#v", err)
 		}
@@ -318,20 +495,23 @@ func (client *AliyunClient) WithEssClient(do func(*ess.Client) (interface{}, err
 		client.essconn = essconn
 	}
 
-	return do(client.essconn)
+	return client.invoke(ESSCode, func() (interface{}, error) {
+		return do(client.essconn)
+	})
 }
 
 func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(OSSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the OSS client if necessary
 	if client.ossconn == nil {
 		schma := "https"
-		endpoint := client.config.OssEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, OSSCode)
-		}
+		endpoint := client.config.resolveEndpoint(OSSCode)
 		if endpoint == "" {
 			endpointItem, _ := client.describeEndpointForService(strings.ToLower(string(OSSCode)))
 			if endpointItem != nil {
@@ -355,16 +535,16 @@ func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, err
 		}
 
 		log.Printf("[DEBUG] Instantiate OSS client using endpoint: %#v", endpoint)
-		accessKey, secretKey, securityToken, err := client.config.getAuthCredentialByEcsRoleName()
-		if err != nil {
-			return nil, err
-		}
+		accessKey, secretKey, securityToken := client.authCredential.AccessKeyId, client.authCredential.AccessKeySecret, client.authCredential.SecurityToken
 		clientOptions := []oss.ClientOption{oss.UserAgent(client.getUserAgent()),
 			oss.SecurityToken(securityToken)}
 		proxyUrl := client.getHttpProxyUrl()
 		if proxyUrl != nil {
 			clientOptions = append(clientOptions, oss.Proxy(proxyUrl.String()))
 		}
+		if client.recorder != nil {
+			clientOptions = append(clientOptions, oss.HTTPClient(&http.Client{Transport: client.recorder}))
+		}
 
 		ossconn, err := oss.New(endpoint, accessKey, secretKey, clientOptions...)
 		if err != nil {
@@ -374,7 +554,9 @@ func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, err
 		client.ossconn = ossconn
 	}
 
-	return do(client.ossconn)
+	return client.invoke(OSSCode, func() (interface{}, error) {
+		return do(client.ossconn)
+	})
 }
 
 func (client *AliyunClient) WithOssBucketByName(bucketName string, do func(*oss.Bucket) (interface{}, error)) (interface{}, error) {
@@ -388,20 +570,21 @@ func (client *AliyunClient) WithOssBucketByName(bucketName string, do func(*oss.
 }
 
 func (client *AliyunClient) WithDnsClient(do func(*alidns.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(DNSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the DNS client if necessary
 	if client.dnsconn == nil {
-		endpoint := client.config.DnsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DNSCode)
-		}
+		endpoint := client.config.resolveEndpoint(DNSCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(DNSCode), endpoint)
 		}
 
-		dnsconn, err := alidns.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		dnsconn, err := alidns.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(DNSCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the DNS client: %#v", err)
 		}
@@ -409,19 +592,22 @@ func (client *AliyunClient) WithDnsClient(do func(*alidns.Client) (interface{},
 		client.dnsconn = dnsconn
 	}
 
-	return do(client.dnsconn)
+	return client.invoke(DNSCode, func() (interface{}, error) {
+		return do(client.dnsconn)
+	})
 }
 
 func (client *AliyunClient) WithRamClient(do func(*ram.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(RAMCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the RAM client if necessary
 	if client.ramconn == nil {
-		endpoint := client.config.RamEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, RAMCode)
-		}
+		endpoint := client.config.resolveEndpoint(RAMCode)
 		if strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "http://"))
 		}
@@ -429,7 +615,7 @@ func (client *AliyunClient) WithRamClient(do func(*ram.Client) (interface{}, err
 			endpoints.AddEndpointMapping(client.config.RegionId, string(RAMCode), endpoint)
 		}
 
-		ramconn, err := ram.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		ramconn, err := ram.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(RAMCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the RAM client: %#v", err)
 		}
@@ -437,54 +623,62 @@ func (client *AliyunClient) WithRamClient(do func(*ram.Client) (interface{}, err
 		client.ramconn = ramconn
 	}
 
-	return do(client.ramconn)
+	return client.invoke(RAMCode, func() (interface{}, error) {
+		return do(client.ramconn)
+	})
 }
 
 func (client *AliyunClient) WithCsClient(do func(*cs.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(CONTAINCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the CS client if necessary
 	if client.csconn == nil {
-		accessKey, secretKey, securityToken, err := client.config.getAuthCredentialByEcsRoleName()
-		if err != nil {
-			return nil, err
-		}
+		accessKey, secretKey, securityToken := client.authCredential.AccessKeyId, client.authCredential.AccessKeySecret, client.authCredential.SecurityToken
 		csconn := cs.NewClientForAussumeRole(accessKey, secretKey, securityToken)
 		csconn.SetUserAgent(client.getUserAgent())
-		endpoint := client.config.CsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CONTAINCode)
-		}
+		endpoint := client.config.resolveEndpoint(CONTAINCode)
 		if endpoint != "" {
 			if !strings.HasPrefix(endpoint, "http") {
 				endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://"))
 			}
 			csconn.SetEndpoint(endpoint)
 		}
+		if client.recorder != nil {
+			if err := client.recorder.hookHttpClient(csconn, "httpClient"); err != nil {
+				return nil, err
+			}
+		}
 		client.csconn = csconn
 	}
 
-	return do(client.csconn)
+	return client.invoke(CONTAINCode, func() (interface{}, error) {
+		return do(client.csconn)
+	})
 }
 
 func (client *AliyunClient) WithCrClient(do func(*cr.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(CRCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the CR client if necessary
 	if client.crconn == nil {
-		endpoint := client.config.CrEndpoint
+		endpoint := client.config.resolveEndpoint(CRCode)
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CRCode)
-			if endpoint == "" {
-				endpoint = fmt.Sprintf("cr.%s.aliyuncs.com", client.config.RegionId)
-			}
+			endpoint = fmt.Sprintf("cr.%s.aliyuncs.com", client.config.RegionId)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(CRCode), endpoint)
 		}
-		crconn, err := cr.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		crconn, err := cr.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(CRCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the CR client: %#v", err)
 		}
@@ -492,49 +686,57 @@ func (client *AliyunClient) WithCrClient(do func(*cr.Client) (interface{}, error
 		client.crconn = crconn
 	}
 
-	return do(client.crconn)
+	return client.invoke(CRCode, func() (interface{}, error) {
+		return do(client.crconn)
+	})
 }
 
 func (client *AliyunClient) WithCdnClient(do func(*cdn.CdnClient) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(CDNCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the CDN client if necessary
 	if client.cdnconn == nil {
-		accessKey, secretKey, securityToken, err := client.config.getAuthCredentialByEcsRoleName()
-		if err != nil {
-			return nil, err
-		}
+		accessKey, secretKey, securityToken := client.authCredential.AccessKeyId, client.authCredential.AccessKeySecret, client.authCredential.SecurityToken
 		cdnconn := cdn.NewClient(accessKey, secretKey)
 		cdnconn.SetBusinessInfo(businessInfoKey)
 		cdnconn.SetUserAgent(client.getUserAgent())
 		cdnconn.SetSecurityToken(securityToken)
-		endpoint := client.config.CdnEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CDNCode)
-		}
+		endpoint := client.config.resolveEndpoint(CDNCode)
 		if endpoint != "" && !strings.HasPrefix(endpoint, "http") {
 			cdnconn.SetEndpoint(fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://")))
 		}
+		if client.recorder != nil {
+			if err := client.recorder.hookHttpClient(cdnconn, "httpClient"); err != nil {
+				return nil, err
+			}
+		}
 		client.cdnconn = cdnconn
 	}
-	return do(client.cdnconn)
+	return client.invoke(CDNCode, func() (interface{}, error) {
+		return do(client.cdnconn)
+	})
 }
 
 func (client *AliyunClient) WithCdnClient_new(do func(*cdn_new.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(CDNCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the CDN client if necessary
 	if client.cdnconn_new == nil {
-		endpoint := client.config.CdnEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CDNCode)
-		}
+		endpoint := client.config.resolveEndpoint(CDNCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(CDNCode), endpoint)
 		}
-		cdnconn, err := cdn_new.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		cdnconn, err := cdn_new.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(CDNCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the CDN client: %#v", err)
 		}
@@ -543,47 +745,53 @@ func (client *AliyunClient) WithCdnClient_new(do func(*cdn_new.Client) (interfac
 		client.cdnconn_new = cdnconn
 	}
 
-	return do(client.cdnconn_new)
+	return client.invoke(CDNCode, func() (interface{}, error) {
+		return do(client.cdnconn_new)
+	})
 }
 
 func (client *AliyunClient) WithKmsClient(do func(*kms.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(KMSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the KMS client if necessary
 	if client.kmsconn == nil {
 
-		endpoint := client.config.KmsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, KMSCode)
-		}
+		endpoint := client.config.resolveEndpoint(KMSCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(KMSCode), endpoint)
 		}
-		kmsconn, err := kms.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		kmsconn, err := kms.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(KMSCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the kms client: %#v", err)
 		}
 		kmsconn.AppendUserAgent(Terraform, version)
 		client.kmsconn = kmsconn
 	}
-	return do(client.kmsconn)
+	return client.invoke(KMSCode, func() (interface{}, error) {
+		return do(client.kmsconn)
+	})
 }
 
 func (client *AliyunClient) WithOtsClient(do func(*ots.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(OTSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the OTS client if necessary
 	if client.otsconn == nil {
-		endpoint := client.config.OtsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, OTSCode)
-		}
+		endpoint := client.config.resolveEndpoint(OTSCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(OTSCode), endpoint)
 		}
-		otsconn, err := ots.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		otsconn, err := ots.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(OTSCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the OTS client: %#v", err)
 		}
@@ -592,16 +800,26 @@ func (client *AliyunClient) WithOtsClient(do func(*ots.Client) (interface{}, err
 		client.otsconn = otsconn
 	}
 
-	return do(client.otsconn)
+	return client.invoke(OTSCode, func() (interface{}, error) {
+		return do(client.otsconn)
+	})
 }
 
 func (client *AliyunClient) WithCmsClient(do func(*cms.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(CMSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the CMS client if necessary
 	if client.cmsconn == nil {
-		cmsconn, err := cms.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(false))
+		endpoint := client.config.resolveEndpoint(CMSCode)
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, string(CMSCode), endpoint)
+		}
+		cmsconn, err := cms.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(CMSCode), client.getAuthCredential(false))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the CMS client: %#v", err)
 		}
@@ -610,25 +828,28 @@ func (client *AliyunClient) WithCmsClient(do func(*cms.Client) (interface{}, err
 		client.cmsconn = cmsconn
 	}
 
-	return do(client.cmsconn)
+	return client.invoke(CMSCode, func() (interface{}, error) {
+		return do(client.cmsconn)
+	})
 }
 
 func (client *AliyunClient) WithPvtzClient(do func(*pvtz.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(PVTZCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the PVTZ client if necessary
 	if client.pvtzconn == nil {
-		endpoint := client.config.PvtzEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, PVTZCode)
-		}
+		endpoint := client.config.resolveEndpoint(PVTZCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(PVTZCode), endpoint)
 		} else {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(PVTZCode), "pvtz.aliyuncs.com")
 		}
-		pvtzconn, err := pvtz.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		pvtzconn, err := pvtz.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(PVTZCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the PVTZ client: %#v", err)
 		}
@@ -637,23 +858,26 @@ func (client *AliyunClient) WithPvtzClient(do func(*pvtz.Client) (interface{}, e
 		client.pvtzconn = pvtzconn
 	}
 
-	return do(client.pvtzconn)
+	return client.invoke(PVTZCode, func() (interface{}, error) {
+		return do(client.pvtzconn)
+	})
 }
 
 func (client *AliyunClient) WithStsClient(do func(*sts.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(STSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the STS client if necessary
 	if client.stsconn == nil {
-		endpoint := client.config.StsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, STSCode)
-		}
+		endpoint := client.config.resolveEndpoint(STSCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(STSCode), endpoint)
 		}
-		stsconn, err := sts.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		stsconn, err := sts.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(STSCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the STS client: %#v", err)
 		}
@@ -662,29 +886,34 @@ func (client *AliyunClient) WithStsClient(do func(*sts.Client) (interface{}, err
 		client.stsconn = stsconn
 	}
 
-	return do(client.stsconn)
+	return client.invoke(STSCode, func() (interface{}, error) {
+		return do(client.stsconn)
+	})
 }
 
 func (client *AliyunClient) WithLogClient(do func(*sls.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(LOGCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
+
+	// The LOG SDK sends all requests by its package level http client, which can not be hooked for one client only.
+	if client.recorder != nil {
+		return nil, fmt.Errorf("the Log Service requests can not be captured or replayed in record mode %q", client.config.RecordMode)
+	}
 
 	// Initialize the LOG client if necessary
 	if client.logconn == nil {
-		endpoint := client.config.LogEndpoint
+		endpoint := client.config.resolveEndpoint(LOGCode)
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, LOGCode)
-			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.log.aliyuncs.com", client.config.RegionId)
-			}
+			endpoint = fmt.Sprintf("%s.log.aliyuncs.com", client.config.RegionId)
 		}
 		if !strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://"))
 		}
-		accessKey, secretKey, securityToken, err := client.config.getAuthCredentialByEcsRoleName()
-		if err != nil {
-			return nil, err
-		}
+		accessKey, secretKey, securityToken := client.authCredential.AccessKeyId, client.authCredential.AccessKeySecret, client.authCredential.SecurityToken
 		client.logconn = &sls.Client{
 			AccessKeyID:     accessKey,
 			AccessKeySecret: secretKey,
@@ -694,24 +923,27 @@ func (client *AliyunClient) WithLogClient(do func(*sls.Client) (interface{}, err
 		}
 	}
 
-	return do(client.logconn)
+	return client.invoke(LOGCode, func() (interface{}, error) {
+		return do(client.logconn)
+	})
 }
 
 func (client *AliyunClient) WithDrdsClient(do func(*drds.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(DRDSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the DRDS client if necessary
 	if client.drdsconn == nil {
-		endpoint := client.config.DrdsEndpoint
+		endpoint := client.config.resolveEndpoint(DRDSCode)
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DRDSCode)
-			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.drds.aliyuncs.com", client.config.RegionId)
-			}
+			endpoint = fmt.Sprintf("%s.drds.aliyuncs.com", client.config.RegionId)
 		}
 
-		drdsconn, err := drds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		drdsconn, err := drds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(DRDSCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the DRDS client: %#v", err)
 
@@ -721,23 +953,26 @@ func (client *AliyunClient) WithDrdsClient(do func(*drds.Client) (interface{}, e
 		client.drdsconn = drdsconn
 	}
 
-	return do(client.drdsconn)
+	return client.invoke(DRDSCode, func() (interface{}, error) {
+		return do(client.drdsconn)
+	})
 }
 
 func (client *AliyunClient) WithDdsClient(do func(*dds.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(DDSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the DDS client if necessary
 	if client.ddsconn == nil {
-		endpoint := client.config.DdsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DDSCode)
-		}
+		endpoint := client.config.resolveEndpoint(DDSCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(DDSCode), endpoint)
 		}
-		ddsconn, err := dds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		ddsconn, err := dds.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(DDSCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the DDS client: %#v", err)
 		}
@@ -746,23 +981,26 @@ func (client *AliyunClient) WithDdsClient(do func(*dds.Client) (interface{}, err
 		client.ddsconn = ddsconn
 	}
 
-	return do(client.ddsconn)
+	return client.invoke(DDSCode, func() (interface{}, error) {
+		return do(client.ddsconn)
+	})
 }
 
 func (client *AliyunClient) WithGpdbClient(do func(*gpdb.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(GPDBCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the GPDB client if necessary
 	if client.gpdbconn == nil {
-		endpoint := client.config.GpdbEnpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, GPDBCode)
-		}
+		endpoint := client.config.resolveEndpoint(GPDBCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(GPDBCode), endpoint)
 		}
-		gpdbconn, err := gpdb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		gpdbconn, err := gpdb.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(GPDBCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the GPDB client: %#v", err)
 		}
@@ -771,23 +1009,26 @@ func (client *AliyunClient) WithGpdbClient(do func(*gpdb.Client) (interface{}, e
 		client.gpdbconn = gpdbconn
 	}
 
-	return do(client.gpdbconn)
+	return client.invoke(GPDBCode, func() (interface{}, error) {
+		return do(client.gpdbconn)
+	})
 }
 
 func (client *AliyunClient) WithRkvClient(do func(*r_kvstore.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(KVSTORECode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the RKV client if necessary
 	if client.rkvconn == nil {
-		endpoint := client.config.KVStoreEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, KVSTORECode)
-		}
+		endpoint := client.config.resolveEndpoint(KVSTORECode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, fmt.Sprintf("R-%s", string(KVSTORECode)), endpoint)
 		}
-		rkvconn, err := r_kvstore.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		rkvconn, err := r_kvstore.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(KVSTORECode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the RKV client: %#v", err)
 		}
@@ -796,21 +1037,24 @@ func (client *AliyunClient) WithRkvClient(do func(*r_kvstore.Client) (interface{
 		client.rkvconn = rkvconn
 	}
 
-	return do(client.rkvconn)
+	return client.invoke(KVSTORECode, func() (interface{}, error) {
+		return do(client.rkvconn)
+	})
 }
 
 func (client *AliyunClient) WithFcClient(do func(*fc.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(FCCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the FC client if necessary
 	if client.fcconn == nil {
-		endpoint := client.config.FcEndpoint
+		endpoint := client.config.resolveEndpoint(FCCode)
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, FCCode)
-			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.fc.aliyuncs.com", client.config.RegionId)
-			}
+			endpoint = fmt.Sprintf("%s.fc.aliyuncs.com", client.config.RegionId)
 		}
 		if strings.HasPrefix(endpoint, "http") {
 			endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
@@ -819,11 +1063,8 @@ func (client *AliyunClient) WithFcClient(do func(*fc.Client) (interface{}, error
 		if err != nil {
 			return nil, err
 		}
-		accessKey, secretKey, securityToken, err := client.config.getAuthCredentialByEcsRoleName()
-		if err != nil {
-			return nil, err
-		}
-		config := client.getSdkConfig()
+		accessKey, secretKey, securityToken := client.authCredential.AccessKeyId, client.authCredential.AccessKeySecret, client.authCredential.SecurityToken
+		config := client.getSdkConfig(FCCode)
 		clientOptions := []fc.ClientOption{fc.WithSecurityToken(securityToken), fc.WithTransport(config.HttpTransport),
 			fc.WithTimeout(30), fc.WithRetryCount(DefaultClientRetryCountSmall)}
 		fcconn, err := fc.NewClient(fmt.Sprintf("https://%s.%s", accountId, endpoint), string(ApiVersion20160815), accessKey, secretKey, clientOptions...)
@@ -832,27 +1073,29 @@ func (client *AliyunClient) WithFcClient(do func(*fc.Client) (interface{}, error
 		}
 
 		fcconn.Config.UserAgent = client.getUserAgent()
-		fcconn.Config.SecurityToken = client.config.SecurityToken
 		client.fcconn = fcconn
 	}
 
-	return do(client.fcconn)
+	return client.invoke(FCCode, func() (interface{}, error) {
+		return do(client.fcconn)
+	})
 }
 
 func (client *AliyunClient) WithCloudApiClient(do func(*cloudapi.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(CLOUDAPICode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the Cloud API client if necessary
 	if client.cloudapiconn == nil {
-		endpoint := client.config.ApigatewayEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.RegionId, CLOUDAPICode)
-		}
+		endpoint := client.config.resolveEndpoint(CLOUDAPICode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.RegionId, "CLOUDAPI", endpoint)
 		}
-		cloudapiconn, err := cloudapi.NewClientWithOptions(client.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		cloudapiconn, err := cloudapi.NewClientWithOptions(client.RegionId, client.getSdkConfig(CLOUDAPICode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the CloudAPI client: %#v", err)
 		}
@@ -861,19 +1104,22 @@ func (client *AliyunClient) WithCloudApiClient(do func(*cloudapi.Client) (interf
 		client.cloudapiconn = cloudapiconn
 	}
 
-	return do(client.cloudapiconn)
+	return client.invoke(CLOUDAPICode, func() (interface{}, error) {
+		return do(client.cloudapiconn)
+	})
 }
 
 func (client *AliyunClient) WithDataHubClient(do func(*datahub.DataHub) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(DATAHUBCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the DataHub client if necessary
 	if client.dhconn == nil {
-		endpoint := client.config.DatahubEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.RegionId, DATAHUBCode)
-		}
+		endpoint := client.config.resolveEndpoint(DATAHUBCode)
 		if endpoint == "" {
 			if client.RegionId == string(APSouthEast1) {
 				endpoint = "dh-singapore.aliyuncs.com"
@@ -884,33 +1130,36 @@ func (client *AliyunClient) WithDataHubClient(do func(*datahub.DataHub) (interfa
 		if !strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", endpoint)
 		}
-		accessKey, secretKey, securityToken, err := client.config.getAuthCredentialByEcsRoleName()
-		if err != nil {
-			return nil, err
-		}
+		accessKey, secretKey, securityToken := client.authCredential.AccessKeyId, client.authCredential.AccessKeySecret, client.authCredential.SecurityToken
 		account := datahub.NewStsCredential(accessKey, secretKey, securityToken)
 		config := &datahub.Config{
 			UserAgent: client.getUserAgent(),
 		}
 
 		client.dhconn = datahub.NewClientWithConfig(endpoint, config, account)
+		if client.recorder != nil {
+			client.dhconn.Client.HttpClient.Transport = client.recorder
+		}
 	}
 
-	return do(client.dhconn)
+	return client.invoke(DATAHUBCode, func() (interface{}, error) {
+		return do(client.dhconn)
+	})
 }
 
 func (client *AliyunClient) WithMnsClient(do func(*ali_mns.MNSClient) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(MNSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the MNS client if necessary
 	if client.mnsconn == nil {
-		endpoint := client.config.MnsEndpoint
+		endpoint := client.config.resolveEndpoint(MNSCode)
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, MNSCode)
-			if endpoint == "" {
-				endpoint = fmt.Sprintf("%s.aliyuncs.com", client.config.RegionId)
-			}
+			endpoint = fmt.Sprintf("%s.aliyuncs.com", client.config.RegionId)
 		}
 
 		accountId, err := client.AccountId()
@@ -921,29 +1170,41 @@ func (client *AliyunClient) WithMnsClient(do func(*ali_mns.MNSClient) (interface
 			endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
 		}
 		mnsUrl := fmt.Sprintf("https://%s.mns.%s", accountId, endpoint)
+		if client.recorder != nil {
+			// The recorder loopback listener serves plain http and forwards requests with https
+			mnsUrl = fmt.Sprintf("http://%s.mns.%s", accountId, endpoint)
+		}
 
-		mnsClient := ali_mns.NewAliMNSClient(mnsUrl, client.config.AccessKey, client.config.SecretKey)
+		mnsClient := ali_mns.NewAliMNSClient(mnsUrl, client.authCredential.AccessKeyId, client.authCredential.AccessKeySecret)
+		if client.recorder != nil {
+			if err := client.recorder.hookMnsClient(mnsClient); err != nil {
+				return nil, err
+			}
+		}
 
 		client.mnsconn = &mnsClient
 	}
 
-	return do(client.mnsconn)
+	return client.invoke(MNSCode, func() (interface{}, error) {
+		return do(client.mnsconn)
+	})
 }
 
 func (client *AliyunClient) WithElasticsearchClient(do func(*elasticsearch.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(ELASTICSEARCHCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the Elasticsearch client if necessary
 	if client.elasticsearchconn == nil {
-		endpoint := client.config.ElasticsearchEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ELASTICSEARCHCode)
-		}
+		endpoint := client.config.resolveEndpoint(ELASTICSEARCHCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(ELASTICSEARCHCode), endpoint)
 		}
-		elasticsearchconn, err := elasticsearch.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		elasticsearchconn, err := elasticsearch.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(ELASTICSEARCHCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the Elasticsearch client: %#v", err)
 		}
@@ -952,7 +1213,9 @@ func (client *AliyunClient) WithElasticsearchClient(do func(*elasticsearch.Clien
 		client.elasticsearchconn = elasticsearchconn
 	}
 
-	return do(client.elasticsearchconn)
+	return client.invoke(ELASTICSEARCHCode, func() (interface{}, error) {
+		return do(client.elasticsearchconn)
+	})
 }
 
 func (client *AliyunClient) WithMnsQueueManager(do func(ali_mns.AliQueueManager) (interface{}, error)) (interface{}, error) {
@@ -977,34 +1240,39 @@ func (client *AliyunClient) WithMnsSubscriptionManagerByTopicName(topicName stri
 }
 
 func (client *AliyunClient) WithTableStoreClient(instanceName string, do func(*tablestore.TableStoreClient) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(OTSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the TABLESTORE client if necessary
 	tableStoreClient, ok := client.tablestoreconnByInstanceName[instanceName]
 	if !ok {
-		endpoint := client.config.OtsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.RegionId, OTSCode)
-		}
+		endpoint := client.config.resolveEndpoint(OTSCode)
 		if endpoint == "" {
 			endpoint = fmt.Sprintf("%s.%s.ots.aliyuncs.com", instanceName, client.RegionId)
 		}
 		if !strings.HasPrefix(endpoint, "https") && !strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", endpoint)
 		}
-		accessKey, secretKey, securityToken, err := client.config.getAuthCredentialByEcsRoleName()
-		if err != nil {
-			return nil, err
+		accessKey, secretKey, securityToken := client.authCredential.AccessKeyId, client.authCredential.AccessKeySecret, client.authCredential.SecurityToken
+		tableStoreConfig := tablestore.NewDefaultTableStoreConfig()
+		if client.recorder != nil {
+			tableStoreConfig.Transport = client.recorder
 		}
-		tableStoreClient = tablestore.NewClientWithConfig(endpoint, instanceName, accessKey, secretKey, securityToken, tablestore.NewDefaultTableStoreConfig())
+		tableStoreClient = tablestore.NewClientWithConfig(endpoint, instanceName, accessKey, secretKey, securityToken, tableStoreConfig)
 		client.tablestoreconnByInstanceName[instanceName] = tableStoreClient
 	}
 
-	return do(tableStoreClient)
+	return client.invoke(OTSCode, func() (interface{}, error) {
+		return do(tableStoreClient)
+	})
 }
 
 func (client *AliyunClient) WithCsProjectClient(clusterId, endpoint string, clusterCerts cs.ClusterCerts, do func(*cs.ProjectClient) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(CONTAINCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 
@@ -1022,12 +1290,14 @@ func (client *AliyunClient) WithCsProjectClient(clusterId, endpoint string, clus
 		client.csprojectconnByKey[key] = csProjectClient
 	}
 
-	return do(csProjectClient)
+	return client.invoke(CONTAINCode, func() (interface{}, error) {
+		return do(csProjectClient)
+	})
 }
 
 func (client *AliyunClient) NewCommonRequest(product, serviceCode, schema string, apiVersion ApiVersion) (*requests.CommonRequest, error) {
 	request := requests.NewCommonRequest()
-	endpoint := loadEndpoint(client.RegionId, ServiceCode(strings.ToUpper(product)))
+	endpoint := client.config.resolveEndpoint(ServiceCode(strings.ToUpper(product)))
 	if endpoint == "" {
 		endpointItem, err := client.describeEndpointForService(serviceCode)
 		if err != nil {
@@ -1069,13 +1339,13 @@ func (client *AliyunClient) AccountId() (string, error) {
 	return client.accountId, nil
 }
 
-func (client *AliyunClient) getSdkConfig() *sdk.Config {
+func (client *AliyunClient) getSdkConfig(code ServiceCode) *sdk.Config {
 	return sdk.NewConfig().
 		WithMaxRetryTime(DefaultClientRetryCountSmall).
 		WithTimeout(time.Duration(30) * time.Second).
 		WithGoRoutinePoolSize(10).
 		WithDebug(false).
-		WithHttpTransport(client.getTransport()).
+		WithHttpTransport(client.getTransport(code)).
 		WithScheme("HTTPS")
 }
 
@@ -1083,7 +1353,7 @@ func (client *AliyunClient) getUserAgent() string {
 	return fmt.Sprintf("HashiCorp-Terraform-v%s", version)
 }
 
-func (client *AliyunClient) getTransport() *http.Transport {
+func (client *AliyunClient) getTransport(code ServiceCode) *http.Transport {
 	handshakeTimeout, err := strconv.Atoi(os.Getenv("TLSHandshakeTimeout"))
 	if err != nil {
 		handshakeTimeout = 120
@@ -1096,6 +1366,20 @@ func (client *AliyunClient) getTransport() *http.Transport {
 	if proxyUrl != nil {
 		transport.Proxy = http.ProxyURL(proxyUrl)
 	}
+	// The requests are sent by an inner transport, because the hooked one would route them back here.
+	var next http.RoundTripper = client.recorder
+	if client.recorder == nil {
+		next = &http.Transport{TLSHandshakeTimeout: transport.TLSHandshakeTimeout, Proxy: transport.Proxy}
+	}
+	if client.rateLimiter != nil && client.rateLimiter.hasActionLimits(code) {
+		next = &rateLimitTransport{limiter: client.rateLimiter, code: code, next: next}
+	}
+	if client.tracer != nil {
+		next = &traceTransport{next: next}
+	}
+	next = &stopTransport{stop: client.StopContext(), next: next}
+	transport.RegisterProtocol("http", next)
+	transport.RegisterProtocol("https", next)
 	return transport
 }
 
@@ -1120,15 +1404,17 @@ func (client *AliyunClient) describeEndpointForService(serviceCode string) (*loc
 	args := location.CreateDescribeEndpointsRequest()
 	args.ServiceCode = serviceCode
 	args.Id = client.config.RegionId
-	args.Domain = client.config.LocationEndpoint
-	if args.Domain == "" {
-		args.Domain = loadEndpoint(client.RegionId, LOCATIONCode)
-	}
+	args.Domain = client.config.resolveEndpoint(LOCATIONCode)
 	if args.Domain == "" {
 		args.Domain = "location-readonly.aliyuncs.com"
 	}
 
-	locationClient, err := location.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	// It is called without goSdkMutex held, so a copy of the credential is used
+	credential, err := client.credential.get()
+	if err != nil {
+		return nil, err
+	}
+	locationClient, err := location.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(LOCATIONCode), credential.sdkCredential(true))
 	if err != nil {
 		return nil, fmt.Errorf("Unable to initialize the location client: %#v", err)
 
@@ -1151,14 +1437,16 @@ func (client *AliyunClient) describeEndpointForService(serviceCode string) (*loc
 func (client *AliyunClient) getCallerIdentity() (*sts.GetCallerIdentityResponse, error) {
 	args := sts.CreateGetCallerIdentityRequest()
 
-	endpoint := client.config.StsEndpoint
-	if endpoint == "" {
-		endpoint = loadEndpoint(client.config.RegionId, STSCode)
-	}
+	endpoint := client.config.resolveEndpoint(STSCode)
 	if endpoint != "" {
 		endpoints.AddEndpointMapping(client.config.RegionId, string(STSCode), endpoint)
 	}
-	stsClient, err := sts.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+	// It is called without goSdkMutex held, so a copy of the credential is used
+	credential, err := client.credential.get()
+	if err != nil {
+		return nil, err
+	}
+	stsClient, err := sts.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(STSCode), credential.sdkCredential(true))
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the STS client: %#v", err)
 	}
@@ -1175,17 +1463,18 @@ func (client *AliyunClient) getCallerIdentity() (*sts.GetCallerIdentityResponse,
 }
 
 func (client *AliyunClient) WithActionTrailClient(do func(*actiontrail.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(ACTIONTRAILCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 	if client.actiontrailconn == nil {
-		endpoint := client.config.ActionTrailEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ACTIONTRAILCode)
-		}
+		endpoint := client.config.resolveEndpoint(ACTIONTRAILCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(ACTIONTRAILCode), endpoint)
 		}
-		actiontrailconn, err := actiontrail.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		actiontrailconn, err := actiontrail.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(ACTIONTRAILCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the ACTIONTRAIL client: %#v", err)
 		}
@@ -1194,16 +1483,26 @@ func (client *AliyunClient) WithActionTrailClient(do func(*actiontrail.Client) (
 		client.actiontrailconn = actiontrailconn
 	}
 
-	return do(client.actiontrailconn)
+	return client.invoke(ACTIONTRAILCode, func() (interface{}, error) {
+		return do(client.actiontrailconn)
+	})
 }
 
 func (client *AliyunClient) WithCasClient(do func(*cas.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(CASCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the CAS client if necessary
 	if client.casconn == nil {
-		casconn, err := cas.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		endpoint := client.config.resolveEndpoint(CASCode)
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, string(CASCode), endpoint)
+		}
+		casconn, err := cas.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(CASCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the CAS client: %#v", err)
 		}
@@ -1212,16 +1511,26 @@ func (client *AliyunClient) WithCasClient(do func(*cas.Client) (interface{}, err
 		client.casconn = casconn
 	}
 
-	return do(client.casconn)
+	return client.invoke(CASCode, func() (interface{}, error) {
+		return do(client.casconn)
+	})
 }
 
 func (client *AliyunClient) WithDdoscooClient(do func(*ddoscoo.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(DDOSCOOCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the ddoscoo client if necessary
 	if client.ddoscooconn == nil {
-		ddoscooconn, err := ddoscoo.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		endpoint := client.config.resolveEndpoint(DDOSCOOCode)
+		if endpoint != "" {
+			endpoints.AddEndpointMapping(client.config.RegionId, string(DDOSCOOCode), endpoint)
+		}
+		ddoscooconn, err := ddoscoo.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(DDOSCOOCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the DDOSCOO client: %#v", err)
 		}
@@ -1229,24 +1538,27 @@ func (client *AliyunClient) WithDdoscooClient(do func(*ddoscoo.Client) (interfac
 		client.ddoscooconn = ddoscooconn
 	}
 
-	return do(client.ddoscooconn)
+	return client.invoke(DDOSCOOCode, func() (interface{}, error) {
+		return do(client.ddoscooconn)
+	})
 }
 
 func (client *AliyunClient) WithBssopenapiClient(do func(*bssopenapi.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(BSSOPENAPICode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the bssopenapi client if necessary
 	if client.bssopenapiconn == nil {
-		endpoint := client.config.BssOpenApiEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, BSSOPENAPICode)
-		}
+		endpoint := client.config.resolveEndpoint(BSSOPENAPICode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(BSSOPENAPICode), endpoint)
 		}
 
-		bssopenapiconn, err := bssopenapi.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		bssopenapiconn, err := bssopenapi.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(BSSOPENAPICode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the BSSOPENAPI client: %#v", err)
 		}
@@ -1254,23 +1566,26 @@ func (client *AliyunClient) WithBssopenapiClient(do func(*bssopenapi.Client) (in
 		client.bssopenapiconn = bssopenapiconn
 	}
 
-	return do(client.bssopenapiconn)
+	return client.invoke(BSSOPENAPICode, func() (interface{}, error) {
+		return do(client.bssopenapiconn)
+	})
 }
 
 func (client *AliyunClient) WithOnsClient(do func(*ons.Client) (interface{}, error)) (interface{}, error) {
+	client.rateLimiter.waitProduct(ONSCode)
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
+	if err := client.refreshCredential(); err != nil {
+		return nil, err
+	}
 
 	// Initialize the ons client if necessary
 	if client.onsconn == nil {
-		endpoint := client.config.OnsEndpoint
-		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ONSCode)
-		}
+		endpoint := client.config.resolveEndpoint(ONSCode)
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(ONSCode), endpoint)
 		}
-		onsconn, err := ons.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
+		onsconn, err := ons.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(ONSCode), client.getAuthCredential(true))
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the ONS client: %#v", err)
 		}
@@ -1278,5 +1593,7 @@ func (client *AliyunClient) WithOnsClient(do func(*ons.Client) (interface{}, err
 		client.onsconn = onsconn
 	}
 
-	return do(client.onsconn)
+	return client.invoke(ONSCode, func() (interface{}, error) {
+		return do(client.onsconn)
+	})
 }
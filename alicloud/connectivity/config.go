@@ -1,23 +1,24 @@
 package connectivity
 
 import (
+	"context"
 	"fmt"
-	"log"
-
-	"encoding/json"
-	"net/http"
 	"strings"
-
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
-	"github.com/jmespath/go-jmespath"
+	"time"
 )
 
 var securityCredURL = "http://100.100.100.200/latest/meta-data/ram/security-credentials/"
 
 // Config of aliyun
+// AssumeRole describes a RAM role to assume by AssumeRole.
+type AssumeRole struct {
+	RoleArn           string
+	SessionName       string
+	Policy            string
+	ExternalId        string
+	SessionExpiration int
+}
+
 type Config struct {
 	AccessKey       string
 	SecretKey       string
@@ -28,159 +29,92 @@ type Config struct {
 	OtsInstanceName string
 	AccountId       string
 
-	RamRoleArn               string
-	RamRoleSessionName       string
-	RamRolePolicy            string
-	RamRoleSessionExpiration int
-
-	EcsEndpoint           string
-	RdsEndpoint           string
-	SlbEndpoint           string
-	VpcEndpoint           string
-	CenEndpoint           string
-	EssEndpoint           string
-	OssEndpoint           string
-	OnsEndpoint           string
-	DnsEndpoint           string
-	RamEndpoint           string
-	CsEndpoint            string
-	CrEndpoint            string
-	CdnEndpoint           string
-	KmsEndpoint           string
-	OtsEndpoint           string
-	CmsEndpoint           string
-	PvtzEndpoint          string
-	StsEndpoint           string
-	LogEndpoint           string
-	DrdsEndpoint          string
-	DdsEndpoint           string
-	GpdbEnpoint           string
-	KVStoreEndpoint       string
-	FcEndpoint            string
-	ApigatewayEndpoint    string
-	DatahubEndpoint       string
-	MnsEndpoint           string
-	LocationEndpoint      string
-	ElasticsearchEndpoint string
-	NasEndpoint           string
-	ActionTrailEndpoint   string
-	BssOpenApiEndpoint    string
-	DdoscooEndpoint       string
-
-	SkipRegionValidation bool
-}
+	// The RAM roles assumed in order, each with the credential of the previous one.
+	AssumeRoles []AssumeRole
 
-func (c *Config) loadAndValidate() error {
-	err := c.validateRegion()
-	if err != nil {
-		return err
-	}
+	// The RAM role assumed with an OIDC token by AssumeRoleWithOIDC.
+	OidcProviderArn           string
+	OidcTokenFile             string
+	OidcRoleArn               string
+	OidcRoleSessionName       string
+	OidcRoleSessionExpiration int
 
-	return nil
-}
+	// DisableEcsMetadataV1 forbids accessing the ECS metadata in the normal mode when the hardened mode is unavailable.
+	DisableEcsMetadataV1 bool
+	// CredentialProcess is an external command which prints the credential.
+	CredentialProcess string
 
-func (c *Config) validateRegion() error {
+	// Endpoints overrides the endpoints of the products in the provider region.
+	Endpoints map[ServiceCode]string
 
-	for _, valid := range ValidRegions {
-		if c.Region == valid {
-			return nil
-		}
-	}
-
-	return fmt.Errorf("Invalid Alibaba Cloud region: %s", c.RegionId)
+	SkipRegionValidation bool
+	// RegionCacheFile is the path of the on-disk cache of the regions discovered by the API.
+	RegionCacheFile string
+
+	// The account of the credential is validated at configure time unless SkipCredentialsValidation is set, and it
+	// must be one of AllowedAccountIds and none of ForbiddenAccountIds.
+	SkipCredentialsValidation bool
+	AllowedAccountIds         []string
+	ForbiddenAccountIds       []string
+
+	// RecordMode and CassettePath are used to capture API exchanges to a cassette file or replay them from it.
+	RecordMode   RecordMode
+	CassettePath string
+
+	// Every API call is traced as a JSON line to TraceFile, or to the log when it is empty. The redacted request and
+	// response bodies are traced too when TraceFullBody is set.
+	TraceFile     string
+	TraceFullBody bool
+
+	// The metrics of the API calls are written to MetricsReportFile in MetricsReportFormat when it is set.
+	MetricsReportFile   string
+	MetricsReportFormat MetricsFormat
+
+	// The lookups of the resources refreshed at the same time are coalesced into batched Describe calls when
+	// BatchDescribe is set.
+	BatchDescribe bool
+
+	// DefaultTags are added to every taggable resource, and the tags matched by IgnoreTagKeys and
+	// IgnoreTagKeyPrefixes are never managed by any resource.
+	DefaultTags          map[string]string
+	IgnoreTagKeys        []string
+	IgnoreTagKeyPrefixes []string
+
+	// RateLimits overrides the default QPS limits of the API requests per product or per API action.
+	RateLimits []RateLimit
+
+	// MaxRetries and MaxRetryTimeout limit the retries of the throttled and transient errors, see RetryEngine.
+	MaxRetries      int
+	MaxRetryTimeout time.Duration
+
+	// StopContext is done when Terraform stops the provider, and then the API calls return ErrStopped at once.
+	StopContext context.Context
 }
 
-func (c *Config) getAuthCredential(stsSupported bool) auth.Credential {
-	if c.AccessKey != "" && c.SecretKey != "" {
-		if c.RamRoleArn != "" {
-			log.Printf("[INFO] Assume RAM Role specified in provider block assume_role { ... }")
-			return credentials.NewRamRoleArnWithPolicyCredential(
-				c.AccessKey, c.SecretKey, c.RamRoleArn,
-				c.RamRoleSessionName, c.RamRolePolicy, c.RamRoleSessionExpiration)
-		}
-		if stsSupported {
-			return credentials.NewStsTokenCredential(c.AccessKey, c.SecretKey, c.SecurityToken)
-		}
-		return credentials.NewAccessKeyCredential(c.AccessKey, c.SecretKey)
-	}
-	if c.EcsRoleName != "" {
-		return credentials.NewEcsRamRoleCredential(c.EcsRoleName)
-	}
-
-	return credentials.NewAccessKeyCredential(c.AccessKey, c.SecretKey)
+// forRegion returns a copy of the config for another region. The endpoints of the provider block are dropped because
+// they are specific to the provider region, and the endpoints of the region are resolved instead.
+func (c *Config) forRegion(regionId string) *Config {
+	config := *c
+	config.Region = Region(regionId)
+	config.RegionId = regionId
+	config.Endpoints = nil
+	return &config
 }
 
-// getAuthCredentialByEcsRoleName aims to access meta to get sts credential
-// Actually, the job should be done by sdk, but currently not all resources and products support alibaba-cloud-sdk-go,
-// and their go sdk does support ecs role name.
-// This method is a temporary solution and it should be removed after all go sdk support ecs role name
-// The related PR: https://github.com/terraform-providers/terraform-provider-alicloud/pull/731
-func (c *Config) getAuthCredentialByEcsRoleName() (accessKey, secretKey, token string, err error) {
-	if c.AccessKey != "" {
-		return c.AccessKey, c.SecretKey, c.SecurityToken, nil
-	}
-	if c.EcsRoleName == "" {
-		return
-	}
-	requestUrl := securityCredURL + c.EcsRoleName
-	httpRequest, err := http.NewRequest(requests.GET, requestUrl, strings.NewReader(""))
-	if err != nil {
-		err = fmt.Errorf("build sts requests err: %s", err.Error())
-		return
-	}
-	httpClient := &http.Client{}
-	httpResponse, err := httpClient.Do(httpRequest)
-	if err != nil {
-		err = fmt.Errorf("get Ecs sts token err : %s", err.Error())
-		return
-	}
-
-	response := responses.NewCommonResponse()
-	err = responses.Unmarshal(response, httpResponse, "")
-	if err != nil {
-		err = fmt.Errorf("Unmarshal Ecs sts token response err : %s", err.Error())
-		return
-	}
-
-	if response.GetHttpStatus() != http.StatusOK {
-		err = fmt.Errorf("get Ecs sts token err, httpStatus: %d, message = %s", response.GetHttpStatus(), response.GetHttpContentString())
-		return
-	}
-	var data interface{}
-	err = json.Unmarshal(response.GetHttpContentBytes(), &data)
-	if err != nil {
-		err = fmt.Errorf("refresh Ecs sts token err, json.Unmarshal fail: %s", err.Error())
-		return
-	}
-	code, err := jmespath.Search("Code", data)
-	if err != nil {
-		err = fmt.Errorf("refresh Ecs sts token err, fail to get Code: %s", err.Error())
-		return
-	}
-	if code.(string) != "Success" {
-		err = fmt.Errorf("refresh Ecs sts token err, Code is not Success")
-		return
-	}
-	accessKeyId, err := jmespath.Search("AccessKeyId", data)
-	if err != nil {
-		err = fmt.Errorf("refresh Ecs sts token err, fail to get AccessKeyId: %s", err.Error())
-		return
-	}
-	accessKeySecret, err := jmespath.Search("AccessKeySecret", data)
-	if err != nil {
-		err = fmt.Errorf("refresh Ecs sts token err, fail to get AccessKeySecret: %s", err.Error())
-		return
-	}
-	securityToken, err := jmespath.Search("SecurityToken", data)
-	if err != nil {
-		err = fmt.Errorf("refresh Ecs sts token err, fail to get SecurityToken: %s", err.Error())
-		return
+// validateAccountId checks the account of the credential against AllowedAccountIds and ForbiddenAccountIds.
+func (c *Config) validateAccountId(accountId string) error {
+	if len(c.AllowedAccountIds) > 0 {
+		for _, id := range c.AllowedAccountIds {
+			if id == accountId {
+				return nil
+			}
+		}
+		return fmt.Errorf("Alibaba Cloud account ID not allowed: %s. The allowed account IDs are %s.", accountId, strings.Join(c.AllowedAccountIds, ", "))
 	}
-
-	if accessKeyId == nil || accessKeySecret == nil || securityToken == nil {
-		err = fmt.Errorf("there is no any available accesskey, secret and security token for Ecs role %s", c.EcsRoleName)
-		return
+	for _, id := range c.ForbiddenAccountIds {
+		if id == accountId {
+			return fmt.Errorf("Alibaba Cloud account ID not allowed: %s. It is one of the forbidden account IDs.", accountId)
+		}
 	}
-
-	return accessKeyId.(string), accessKeySecret.(string), securityToken.(string), nil
+	return nil
 }
@@ -0,0 +1,81 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeRegionCache writes a fresh region cache, so that the regions are not discovered by the API in the tests.
+func writeRegionCache(t *testing.T, regionIds ...string) string {
+	dir, err := ioutil.TempDir("", "region-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(map[ServiceCode]regionCacheEntry{
+		ECSCode: {RegionIds: regionIds, UpdatedAt: time.Now()},
+	})
+	path := filepath.Join(dir, "regions.json")
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestValidateAccountId(t *testing.T) {
+	cases := []struct {
+		config    Config
+		accountId string
+		valid     bool
+	}{
+		{Config{}, "123", true},
+		{Config{AllowedAccountIds: []string{"123", "456"}}, "123", true},
+		{Config{AllowedAccountIds: []string{"456"}}, "123", false},
+		{Config{ForbiddenAccountIds: []string{"123"}}, "123", false},
+		{Config{ForbiddenAccountIds: []string{"456"}}, "123", true},
+	}
+	for i, c := range cases {
+		if err := c.config.validateAccountId(c.accountId); (err == nil) != c.valid {
+			t.Fatalf("case %d: expected valid %t, got error %v", i, c.valid, err)
+		}
+	}
+}
+
+func TestForRegion(t *testing.T) {
+	config := &Config{
+		Region:                    Region("cn-hangzhou"),
+		RegionId:                  "cn-hangzhou",
+		AccessKey:                 "ak",
+		SecretKey:                 "sk",
+		Endpoints:                 map[ServiceCode]string{ECSCode: "ecs-vpc.cn-hangzhou.aliyuncs.com"},
+		SkipCredentialsValidation: true,
+		RegionCacheFile:           writeRegionCache(t, "cn-hangzhou", "cn-beijing"),
+	}
+	defer os.RemoveAll(filepath.Dir(config.RegionCacheFile))
+	client, err := config.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regional, err := client.ForRegion("cn-hangzhou"); err != nil || regional != client {
+		t.Fatalf("the client itself is expected for its own region, got error %v", err)
+	}
+	regional, err := client.ForRegion("cn-beijing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regional.RegionId != "cn-beijing" || regional.config.resolveEndpoint(ECSCode) != "" || regional.credential != client.credential {
+		t.Fatalf("unexpected client of region cn-beijing: %#v", regional)
+	}
+	if cached, _ := regional.ForRegion("cn-beijing"); cached != regional {
+		t.Fatalf("the client of a region is expected to be cached")
+	}
+	if cached, _ := client.ForRegion("cn-beijing"); cached != regional {
+		t.Fatalf("the client of a region is expected to be cached")
+	}
+	if _, err := client.ForRegion("cn-nowhere"); err == nil {
+		t.Fatalf("an error is expected for an invalid region")
+	}
+}
@@ -0,0 +1,128 @@
+package connectivity
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrStopped is returned by the API calls which are aborted because Terraform stops the provider, like on Ctrl-C.
+var ErrStopped = errors.New("the operation is aborted because Terraform is stopping the provider")
+
+// StopContext returns the context which is done when Terraform stops the provider.
+func (client *AliyunClient) StopContext() context.Context {
+	if client == nil || client.config == nil || client.config.StopContext == nil {
+		return context.Background()
+	}
+	return client.config.StopContext
+}
+
+// invoke runs an API call of the product, and traces it and records it in the metrics.
+func (client *AliyunClient) invoke(product ServiceCode, do func() (interface{}, error)) (interface{}, error) {
+	if client.tracer == nil {
+		return client.invokeWithStop(do)
+	}
+	trace := client.tracer.start(product, client.RegionId)
+	raw, err := client.invokeWithStop(do)
+	client.tracer.finish(trace, raw, err)
+	if client.metrics != nil {
+		client.metrics.record(trace, client.resourceType)
+	}
+	return raw, err
+}
+
+// invokeWithStop runs an API call unless the provider is already stopped. A read-only call of the product SDK clients
+// which is in flight when the provider stops is aborted by stopTransport. The other calls are waited for and their
+// result is kept, like the instance created by them, and then the next API call or sleepContext returns ErrStopped.
+// The call is never left running on the shared client.
+func (client *AliyunClient) invokeWithStop(do func() (interface{}, error)) (interface{}, error) {
+	if client.StopContext().Err() != nil {
+		return nil, ErrStopped
+	}
+	raw, err := do()
+	if err != nil && client.StopContext().Err() != nil && isAborted(err) {
+		return raw, ErrStopped
+	}
+	return raw, err
+}
+
+// isAborted reports whether the error comes from a request aborted by stopTransport. The SDKs wrap the error of the
+// transport, so only its message is kept.
+func isAborted(err error) bool {
+	message := err.Error()
+	return strings.Contains(message, ErrStopped.Error()) || strings.Contains(message, context.Canceled.Error())
+}
+
+// readOnlyActionPrefixes are the prefixes of the API actions which do not change any resource, so they can be aborted
+// at any time.
+var readOnlyActionPrefixes = []string{"Describe", "List", "Query", "Get"}
+
+func isReadOnlyAction(action string) bool {
+	for _, prefix := range readOnlyActionPrefixes {
+		if strings.HasPrefix(action, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// stopTransport aborts the read-only requests of the product SDK clients, like the Describe calls of the waiters, when
+// the provider is stopped. The requests which may change a resource are left to finish, so their result is not lost.
+type stopTransport struct {
+	stop context.Context
+	next http.RoundTripper
+}
+
+func (t *stopTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	action, err := requestAction(req)
+	if err != nil {
+		return nil, err
+	}
+	if !isReadOnlyAction(action) {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithCancel(req.Context())
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-t.stop.Done():
+			cancel()
+		case <-done:
+		}
+	}()
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			close(done)
+			cancel()
+		})
+	}
+
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		release()
+		if t.stop.Err() != nil {
+			return nil, ErrStopped
+		}
+		return nil, err
+	}
+	// The response body is read after RoundTrip returns, so the request can be aborted until the body is closed.
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseOnClose calls release once the response body is closed.
+type releaseOnClose struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
@@ -0,0 +1,72 @@
+package connectivity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestInvokeStopped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := &AliyunClient{config: &Config{StopContext: ctx}}
+
+	raw, err := client.invoke(ECSCode, func() (interface{}, error) {
+		return "ok", nil
+	})
+	if err != nil || raw != "ok" {
+		t.Fatalf("the call is expected to return its result, got %v and error %v", raw, err)
+	}
+
+	// The in-flight call is waited for and its result is kept.
+	raw, err = client.invoke(ECSCode, func() (interface{}, error) {
+		cancel()
+		time.Sleep(10 * time.Millisecond)
+		return "created", nil
+	})
+	if err != nil || raw != "created" {
+		t.Fatalf("the in-flight call is expected to return its result, got %v and error %v", raw, err)
+	}
+
+	called := false
+	_, err = client.invoke(ECSCode, func() (interface{}, error) {
+		called = true
+		return nil, nil
+	})
+	if err != ErrStopped || called {
+		t.Fatalf("no call is expected to be made after the provider is stopped, got error %v", err)
+	}
+}
+
+func TestStopTransport(t *testing.T) {
+	requests := make(chan struct{}, 2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests <- struct{}{}
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	httpClient := &http.Client{Transport: &stopTransport{stop: ctx, next: &http.Transport{}}}
+	go func() {
+		<-requests
+		cancel()
+	}()
+
+	start := time.Now()
+	_, err := httpClient.Get(server.URL + "/?Action=DescribeInstances")
+	if err == nil || time.Since(start) >= 200*time.Millisecond {
+		t.Fatalf("the read-only request is expected to be aborted when the provider is stopped, got error %v", err)
+	}
+
+	resp, err := httpClient.Get(server.URL + "/?Action=RunInstances")
+	if err != nil {
+		t.Fatalf("the request which may change a resource is expected to finish, got error %v", err)
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,510 @@
+package connectivity
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/endpoints"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
+	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
+)
+
+// A credential is retrieved again when it expires within the window, so that the running requests never use an expired one.
+const credentialRefreshWindow = 5 * time.Minute
+
+const (
+	DefaultStsEndpoint          = "sts.aliyuncs.com"
+	DefaultEcsMetadataTokenTTL  = 21600
+	DefaultCredentialProcessTTL = time.Minute
+)
+
+var securityCredTokenURL = "http://100.100.100.200/latest/api/token"
+
+var errCredentialNotConfigured = errors.New("the credential source is not configured")
+
+// Credential is the AccessKey, and the STS token if any, used to sign the API requests.
+type Credential struct {
+	AccessKeyId     string
+	AccessKeySecret string
+	SecurityToken   string
+	// Expiration is zero for a long-term AccessKey.
+	Expiration time.Time
+}
+
+func (c *Credential) expiresWithin(window time.Duration) bool {
+	return !c.Expiration.IsZero() && time.Now().Add(window).After(c.Expiration)
+}
+
+// CredentialProvider retrieves a credential from one source. Retrieve returns errCredentialNotConfigured
+// when the source is not configured, and then the next provider of the chain is tried.
+type CredentialProvider interface {
+	Name() string
+	Retrieve() (*Credential, error)
+}
+
+// staticCredentialProvider returns the AccessKey set by the provider block, the environment variables or the profile.
+type staticCredentialProvider struct {
+	credential Credential
+}
+
+func (p *staticCredentialProvider) Name() string {
+	return "static credential"
+}
+
+func (p *staticCredentialProvider) Retrieve() (*Credential, error) {
+	if p.credential.AccessKeyId == "" || p.credential.AccessKeySecret == "" {
+		return nil, errCredentialNotConfigured
+	}
+	credential := p.credential
+	return &credential, nil
+}
+
+// oidcCredentialProvider exchanges an OIDC token, like the one mounted by RRSA in a container, for an STS token
+// by calling AssumeRoleWithOIDC. The token file is read again on every call because it is rotated.
+type oidcCredentialProvider struct {
+	providerArn     string
+	tokenFile       string
+	roleArn         string
+	sessionName     string
+	durationSeconds int
+	endpoint        string
+	httpClient      *http.Client
+}
+
+func (p *oidcCredentialProvider) Name() string {
+	return "OIDC role " + p.roleArn
+}
+
+func (p *oidcCredentialProvider) Retrieve() (*Credential, error) {
+	if p.providerArn == "" || p.tokenFile == "" || p.roleArn == "" {
+		return nil, errCredentialNotConfigured
+	}
+	token, err := ioutil.ReadFile(p.tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading the OIDC token file %s got an error: %s", p.tokenFile, err)
+	}
+
+	query := url.Values{}
+	query.Set("Action", "AssumeRoleWithOIDC")
+	query.Set("Format", "JSON")
+	query.Set("Version", "2015-04-01")
+	query.Set("Timestamp", time.Now().UTC().Format("2006-01-02T15:04:05Z"))
+	form := url.Values{}
+	form.Set("RoleArn", p.roleArn)
+	form.Set("OIDCProviderArn", p.providerArn)
+	form.Set("OIDCToken", strings.TrimSpace(string(token)))
+	form.Set("RoleSessionName", p.sessionName)
+	if p.durationSeconds > 0 {
+		form.Set("DurationSeconds", strconv.Itoa(p.durationSeconds))
+	}
+	request, err := http.NewRequest(requests.POST, fmt.Sprintf("https://%s/?%s", p.endpoint, query.Encode()), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var response struct {
+		Code        string
+		Message     string
+		Credentials sts.Credentials
+	}
+	status, err := doCredentialRequest(p.httpClient, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("AssumeRoleWithOIDC got an error, httpStatus: %d, code: %s, message: %s", status, response.Code, response.Message)
+	}
+	return stsCredential(response.Credentials)
+}
+
+// ecsMetadataCredentialProvider retrieves the STS token of the RAM role attached to the ECS instance.
+// The metadata is accessed in the hardened mode (IMDSv2) with a session token, and it falls back to
+// the normal mode only when the hardened mode is unavailable and the fallback is not disabled.
+type ecsMetadataCredentialProvider struct {
+	roleName      string
+	disableImdsV1 bool
+	httpClient    *http.Client
+}
+
+func (p *ecsMetadataCredentialProvider) Name() string {
+	return "ECS RAM role " + p.roleName
+}
+
+func (p *ecsMetadataCredentialProvider) metadataToken() (string, error) {
+	request, err := http.NewRequest(requests.PUT, securityCredTokenURL, nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("X-aliyun-ecs-metadata-token-ttl-seconds", strconv.Itoa(DefaultEcsMetadataTokenTTL))
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("httpStatus: %d, message: %s", response.StatusCode, string(body))
+	}
+	return string(body), nil
+}
+
+func (p *ecsMetadataCredentialProvider) Retrieve() (*Credential, error) {
+	if p.roleName == "" {
+		return nil, errCredentialNotConfigured
+	}
+	request, err := http.NewRequest(requests.GET, securityCredURL+p.roleName, nil)
+	if err != nil {
+		return nil, err
+	}
+	token, err := p.metadataToken()
+	if err != nil {
+		if p.disableImdsV1 {
+			return nil, fmt.Errorf("getting the ECS metadata token got an error: %s", err)
+		}
+		log.Printf("[WARN] Getting the ECS metadata token got an error: %s. Falling back to the normal mode.", err)
+	} else {
+		request.Header.Set("X-aliyun-ecs-metadata-token", token)
+	}
+
+	var response struct {
+		Code string
+		sts.Credentials
+	}
+	status, err := doCredentialRequest(p.httpClient, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK || response.Code != "Success" {
+		return nil, fmt.Errorf("getting the STS token of the ECS RAM role %s got an error, httpStatus: %d, code: %s", p.roleName, status, response.Code)
+	}
+	return stsCredential(response.Credentials)
+}
+
+// processCredentialProvider runs an external command and reads a credential from its output, which is a JSON object
+// with access_key_id, access_key_secret, and the optional sts_token and expiration in RFC3339 format. The credential
+// without an expiration is cached for DefaultCredentialProcessTTL.
+type processCredentialProvider struct {
+	command string
+}
+
+func (p *processCredentialProvider) Name() string {
+	return "credential_process"
+}
+
+func (p *processCredentialProvider) Retrieve() (*Credential, error) {
+	if p.command == "" {
+		return nil, errCredentialNotConfigured
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", p.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", p.command)
+	}
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("running the credential_process got an error: %s", err)
+	}
+
+	var result struct {
+		Mode            string `json:"mode"`
+		AccessKeyId     string `json:"access_key_id"`
+		AccessKeySecret string `json:"access_key_secret"`
+		StsToken        string `json:"sts_token"`
+		Expiration      string `json:"expiration"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil, fmt.Errorf("parsing the output of the credential_process got an error: %s", err)
+	}
+	if result.AccessKeyId == "" || result.AccessKeySecret == "" {
+		return nil, fmt.Errorf("the output of the credential_process does not contain access_key_id and access_key_secret")
+	}
+	credential := &Credential{
+		AccessKeyId:     result.AccessKeyId,
+		AccessKeySecret: result.AccessKeySecret,
+		SecurityToken:   result.StsToken,
+		Expiration:      time.Now().Add(DefaultCredentialProcessTTL + credentialRefreshWindow),
+	}
+	if result.Expiration != "" {
+		if credential.Expiration, err = time.Parse(time.RFC3339, result.Expiration); err != nil {
+			return nil, fmt.Errorf("parsing the expiration of the credential_process output got an error: %s", err)
+		}
+	}
+	return credential, nil
+}
+
+// chainCredentialProvider returns the credential of the first configured provider.
+type chainCredentialProvider struct {
+	providers []CredentialProvider
+}
+
+func (p *chainCredentialProvider) Name() string {
+	return "credential chain"
+}
+
+func (p *chainCredentialProvider) Retrieve() (*Credential, error) {
+	for _, provider := range p.providers {
+		credential, err := provider.Retrieve()
+		if err == errCredentialNotConfigured {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("retrieving the credential from the %s got an error: %s", provider.Name(), err)
+		}
+		log.Printf("[DEBUG] Using the credential from the %s", provider.Name())
+		return credential, nil
+	}
+	return nil, fmt.Errorf("No valid credential sources found for the Alicloud Provider. Please set access_key and secret_key, " +
+		"ecs_role_name, assume_role_with_oidc or credential_process in the provider block, the environment variables or the profile.")
+}
+
+// assumeRoleCredentialProvider assumes a RAM role with the credential of the source provider.
+type assumeRoleCredentialProvider struct {
+	source          *credentialCache
+	roleArn         string
+	sessionName     string
+	policy          string
+	externalId      string
+	durationSeconds int
+	regionId        string
+	endpoint        string
+	transport       *http.Transport
+}
+
+func (p *assumeRoleCredentialProvider) Name() string {
+	return "assumed role " + p.roleArn
+}
+
+func (p *assumeRoleCredentialProvider) Retrieve() (*Credential, error) {
+	source, err := p.source.get()
+	if err != nil {
+		return nil, err
+	}
+	if p.endpoint != "" {
+		endpoints.AddEndpointMapping(p.regionId, string(STSCode), p.endpoint)
+	}
+	config := sdk.NewConfig().WithTimeout(30 * time.Second).WithHttpTransport(p.transport).WithScheme("HTTPS")
+	stsClient, err := sts.NewClientWithOptions(p.regionId, config, source.sdkCredential(true))
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the STS client: %#v", err)
+	}
+	stsClient.AppendUserAgent(Terraform, version)
+
+	request := sts.CreateAssumeRoleRequest()
+	request.RoleArn = p.roleArn
+	request.RoleSessionName = p.sessionName
+	request.Policy = p.policy
+	if p.externalId != "" {
+		// ExternalId is not supported by the request of the vendored SDK yet.
+		request.QueryParams["ExternalId"] = p.externalId
+	}
+	if p.durationSeconds > 0 {
+		request.DurationSeconds = requests.NewInteger(p.durationSeconds)
+	}
+	response, err := stsClient.AssumeRole(request)
+	if err != nil {
+		return nil, err
+	}
+	return stsCredential(response.Credentials)
+}
+
+// credentialCache caches the credential of a provider, and retrieves a new one before it expires.
+type credentialCache struct {
+	provider   CredentialProvider
+	credential *Credential
+	mutex      sync.Mutex
+}
+
+func newCredentialCache(provider CredentialProvider) *credentialCache {
+	return &credentialCache{provider: provider}
+}
+
+func (c *credentialCache) get() (*Credential, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.credential != nil && !c.credential.expiresWithin(credentialRefreshWindow) {
+		return c.credential, nil
+	}
+	credential, err := c.provider.Retrieve()
+	if err != nil {
+		// A failed refresh is not fatal until the old credential really expires.
+		if c.credential != nil && !c.credential.expiresWithin(0) {
+			log.Printf("[WARN] Refreshing the credential from the %s got an error: %s. Keep using the old one.", c.provider.Name(), err)
+			return c.credential, nil
+		}
+		return nil, err
+	}
+	c.credential = credential
+	return c.credential, nil
+}
+
+func (c *Credential) sdkCredential(stsSupported bool) auth.Credential {
+	if stsSupported {
+		return credentials.NewStsTokenCredential(c.AccessKeyId, c.AccessKeySecret, c.SecurityToken)
+	}
+	return credentials.NewAccessKeyCredential(c.AccessKeyId, c.AccessKeySecret)
+}
+
+func stsCredential(c sts.Credentials) (*Credential, error) {
+	if c.AccessKeyId == "" || c.AccessKeySecret == "" || c.SecurityToken == "" {
+		return nil, fmt.Errorf("the response does not contain any AccessKeyId, AccessKeySecret and SecurityToken")
+	}
+	expiration, err := time.Parse(time.RFC3339, c.Expiration)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the expiration %s got an error: %s", c.Expiration, err)
+	}
+	return &Credential{
+		AccessKeyId:     c.AccessKeyId,
+		AccessKeySecret: c.AccessKeySecret,
+		SecurityToken:   c.SecurityToken,
+		Expiration:      expiration,
+	}, nil
+}
+
+func doCredentialRequest(httpClient *http.Client, request *http.Request, result interface{}) (int, error) {
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return response.StatusCode, err
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return response.StatusCode, fmt.Errorf("parsing the response got an error: %s, httpStatus: %d", err, response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
+
+// credentialTransport builds the transport of the credential requests. It is never hooked by the recorder,
+// so that no credential is written to the cassette.
+func (client *AliyunClient) credentialTransport() *http.Transport {
+	transport := &http.Transport{TLSHandshakeTimeout: 30 * time.Second}
+	if proxyUrl := client.getHttpProxyUrl(); proxyUrl != nil {
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+	return transport
+}
+
+// credentialProvider builds the credential chain: the static AccessKey, the OIDC role, the ECS RAM role and the
+// credential_process in order. The RAM roles of assume_role, if any, are assumed in order, the first one with the
+// credential of the chain and each of the others with the credential of the previous role.
+func (c *Config) credentialProvider(client *AliyunClient) CredentialProvider {
+	stsEndpoint := c.resolveEndpoint(STSCode)
+	oidcEndpoint := strings.TrimPrefix(strings.TrimPrefix(stsEndpoint, "https://"), "http://")
+	if oidcEndpoint == "" {
+		oidcEndpoint = DefaultStsEndpoint
+	}
+
+	var provider CredentialProvider = &chainCredentialProvider{
+		providers: []CredentialProvider{
+			&staticCredentialProvider{
+				credential: Credential{AccessKeyId: c.AccessKey, AccessKeySecret: c.SecretKey, SecurityToken: c.SecurityToken},
+			},
+			&oidcCredentialProvider{
+				providerArn:     c.OidcProviderArn,
+				tokenFile:       c.OidcTokenFile,
+				roleArn:         c.OidcRoleArn,
+				sessionName:     c.OidcRoleSessionName,
+				durationSeconds: c.OidcRoleSessionExpiration,
+				endpoint:        oidcEndpoint,
+				httpClient:      &http.Client{Transport: client.credentialTransport(), Timeout: 30 * time.Second},
+			},
+			&ecsMetadataCredentialProvider{
+				roleName:      c.EcsRoleName,
+				disableImdsV1: c.DisableEcsMetadataV1,
+				// The metadata service must not be accessed through a proxy.
+				httpClient: &http.Client{Transport: &http.Transport{}, Timeout: 5 * time.Second},
+			},
+			&processCredentialProvider{command: c.CredentialProcess},
+		},
+	}
+	for _, role := range c.AssumeRoles {
+		if role.RoleArn == "" {
+			continue
+		}
+		log.Printf("[INFO] Assume RAM Role %s with the credential of %s", role.RoleArn, provider.Name())
+		sessionName := role.SessionName
+		if sessionName == "" {
+			sessionName = "terraform"
+		}
+		provider = &assumeRoleCredentialProvider{
+			source:          newCredentialCache(provider),
+			roleArn:         role.RoleArn,
+			sessionName:     sessionName,
+			policy:          role.Policy,
+			externalId:      role.ExternalId,
+			durationSeconds: role.SessionExpiration,
+			regionId:        c.RegionId,
+			endpoint:        stsEndpoint,
+			transport:       client.credentialTransport(),
+		}
+	}
+	return provider
+}
+
+// refreshCredential makes all of the clients use the current credential, and it must be called with goSdkMutex held.
+// The credential shared by the SDK clients is updated in place, and the other clients, which keep their own copy,
+// are dropped to be rebuilt with the new one.
+func (client *AliyunClient) refreshCredential() error {
+	credential, err := client.credential.get()
+	if err != nil {
+		return err
+	}
+	if credential == client.authCredential {
+		return nil
+	}
+	if client.authCredential != nil {
+		log.Printf("[DEBUG] The credential is refreshed and will expire at %s", credential.Expiration.Format(time.RFC3339))
+	}
+	client.authCredential = credential
+
+	client.stsCredential.AccessKeyId = credential.AccessKeyId
+	client.stsCredential.AccessKeySecret = credential.AccessKeySecret
+	client.stsCredential.AccessKeyStsToken = credential.SecurityToken
+	client.accessKeyCredential.AccessKeyId = credential.AccessKeyId
+	client.accessKeyCredential.AccessKeySecret = credential.AccessKeySecret
+
+	client.ossconn = nil
+	client.csconn = nil
+	client.cdnconn = nil
+	client.logconn = nil
+	client.fcconn = nil
+	client.dhconn = nil
+	client.mnsconn = nil
+	client.tablestoreconnByInstanceName = make(map[string]*tablestore.TableStoreClient)
+	return nil
+}
+
+// getAuthCredential returns the credential shared by the SDK clients. It is updated in place by refreshCredential,
+// so the clients using it must send their requests with goSdkMutex held.
+func (client *AliyunClient) getAuthCredential(stsSupported bool) auth.Credential {
+	if stsSupported {
+		return client.stsCredential
+	}
+	return client.accessKeyCredential
+}
@@ -0,0 +1,145 @@
+package connectivity
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+type countingCredentialProvider struct {
+	credentials []*Credential
+	calls       int
+}
+
+func (p *countingCredentialProvider) Name() string {
+	return "counting credential"
+}
+
+func (p *countingCredentialProvider) Retrieve() (*Credential, error) {
+	if p.calls >= len(p.credentials) {
+		return nil, fmt.Errorf("no more credentials")
+	}
+	p.calls++
+	return p.credentials[p.calls-1], nil
+}
+
+func TestCredentialCache(t *testing.T) {
+	provider := &countingCredentialProvider{credentials: []*Credential{
+		{AccessKeyId: "first", Expiration: time.Now().Add(time.Hour)},
+		{AccessKeyId: "second", Expiration: time.Now().Add(2 * time.Minute)},
+	}}
+	cache := newCredentialCache(provider)
+	for i := 0; i < 2; i++ {
+		if credential, err := cache.get(); err != nil || credential.AccessKeyId != "first" {
+			t.Fatalf("the first credential is expected to be cached, got %v and error %v", credential, err)
+		}
+	}
+
+	cache.credential.Expiration = time.Now().Add(time.Minute)
+	if credential, err := cache.get(); err != nil || credential.AccessKeyId != "second" {
+		t.Fatalf("the credential expiring within the refresh window is expected to be refreshed, got %v and error %v", credential, err)
+	}
+	if credential, err := cache.get(); err != nil || credential.AccessKeyId != "second" {
+		t.Fatalf("the old credential is expected to be used when refreshing fails before it expires, got %v and error %v", credential, err)
+	}
+	cache.credential.Expiration = time.Now().Add(-time.Second)
+	if _, err := cache.get(); err == nil {
+		t.Fatalf("an error is expected when refreshing fails after the credential expires")
+	}
+}
+
+func TestChainCredentialProvider(t *testing.T) {
+	chain := &chainCredentialProvider{providers: []CredentialProvider{
+		&staticCredentialProvider{credential: Credential{AccessKeyId: "ak"}},
+		&ecsMetadataCredentialProvider{},
+		&processCredentialProvider{command: `echo '{"mode": "AK", "access_key_id": "process", "access_key_secret": "secret"}'`},
+	}}
+	credential, err := chain.Retrieve()
+	if err != nil || credential.AccessKeyId != "process" {
+		t.Fatalf("the first configured provider is expected to be used, got %v and error %v", credential, err)
+	}
+	if credential.Expiration.IsZero() {
+		t.Fatalf("the credential of credential_process without expiration is expected to expire after a while")
+	}
+
+	chain.providers = chain.providers[:2]
+	if _, err := chain.Retrieve(); err == nil {
+		t.Fatalf("an error is expected when no provider is configured")
+	}
+}
+
+func TestEcsMetadataCredentialProvider(t *testing.T) {
+	expiration := time.Now().Add(6 * time.Hour).UTC().Format(time.RFC3339)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			if r.Header.Get("X-aliyun-ecs-metadata-token-ttl-seconds") == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, "metadata-token")
+			return
+		}
+		if r.Header.Get("X-aliyun-ecs-metadata-token") != "metadata-token" || !strings.HasSuffix(r.URL.Path, "/test-role") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprintf(w, `{"Code": "Success", "AccessKeyId": "STS.ak", "AccessKeySecret": "secret", "SecurityToken": "token", "Expiration": "%s"}`, expiration)
+	}))
+	defer server.Close()
+
+	defer func(credURL, tokenURL string) {
+		securityCredURL, securityCredTokenURL = credURL, tokenURL
+	}(securityCredURL, securityCredTokenURL)
+	securityCredURL = server.URL + "/latest/meta-data/ram/security-credentials/"
+	securityCredTokenURL = server.URL + "/latest/api/token"
+
+	provider := &ecsMetadataCredentialProvider{roleName: "test-role", disableImdsV1: true, httpClient: server.Client()}
+	credential, err := provider.Retrieve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credential.AccessKeyId != "STS.ak" || credential.SecurityToken != "token" || credential.Expiration.Format(time.RFC3339) != expiration {
+		t.Fatalf("unexpected credential %v", credential)
+	}
+}
+
+func TestOidcCredentialProvider(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("Action") != "AssumeRoleWithOIDC" || r.FormValue("OIDCToken") != "oidc-token" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"Code": "InvalidParameter", "Message": "unexpected request"}`)
+			return
+		}
+		fmt.Fprint(w, `{"Credentials": {"AccessKeyId": "STS.oidc", "AccessKeySecret": "secret", "SecurityToken": "token", "Expiration": "2099-01-01T00:00:00Z"}}`)
+	}))
+	defer server.Close()
+
+	tokenFile, err := ioutil.TempFile("", "oidc-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(tokenFile.Name())
+	tokenFile.WriteString("oidc-token\n")
+	tokenFile.Close()
+
+	provider := &oidcCredentialProvider{
+		providerArn: "acs:ram::123456:oidc-provider/ack-rrsa",
+		tokenFile:   tokenFile.Name(),
+		roleArn:     "acs:ram::123456:role/terraform",
+		sessionName: "terraform",
+		endpoint:    strings.TrimPrefix(server.URL, "https://"),
+		httpClient:  server.Client(),
+	}
+	credential, err := provider.Retrieve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if credential.AccessKeyId != "STS.oidc" || credential.SecurityToken != "token" {
+		t.Fatalf("unexpected credential %v", credential)
+	}
+}
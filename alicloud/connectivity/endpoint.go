@@ -1,11 +1,17 @@
 package connectivity
 
 import (
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io/ioutil"
+	"log"
 	"os"
+	"path"
 	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
 )
 
 // Load endpoints from endpoints.xml or environment variables to meet specified application scenario, like private cloud.
@@ -46,9 +52,29 @@ const (
 	ACTIONTRAILCode   = ServiceCode("ACTIONTRAIL")
 	BSSOPENAPICode    = ServiceCode("BSSOPENAPI")
 	DDOSCOOCode       = ServiceCode("DDOSCOO")
+	CASCode           = ServiceCode("CAS")
 )
 
-//xml
+// ServiceCodes are all of the products whose endpoints can be overridden.
+var ServiceCodes = []ServiceCode{
+	ECSCode, ESSCode, RAMCode, VPCCode, SLBCode, RDSCode, OSSCode, ONSCode, CONTAINCode, CRCode, DOMAINCode, CDNCode,
+	CMSCode, KMSCode, OTSCode, DNSCode, PVTZCode, LOGCode, FCCode, DDSCode, GPDBCode, STSCode, CENCode, KVSTORECode,
+	DATAHUBCode, MNSCode, CLOUDAPICode, DRDSCode, LOCATIONCode, ELASTICSEARCHCode, NASCode, ACTIONTRAILCode,
+	BSSOPENAPICode, DDOSCOOCode, CASCode,
+}
+
+// Endpoints is the XML format of the endpoints file, like the following, in which RegionId can be repeated and
+// contain the wildcards of path.Match, and Template is used for the products not in Products:
+//
+//	<Endpoints>
+//	  <Endpoint name="cn-*">
+//	    <RegionIds><RegionId>cn-*</RegionId></RegionIds>
+//	    <Products>
+//	      <Product><ProductName>Ecs</ProductName><DomainName>ecs.internal</DomainName></Product>
+//	    </Products>
+//	    <Template>{product}.{region}.example.internal</Template>
+//	  </Endpoint>
+//	</Endpoints>
 type Endpoints struct {
 	Endpoint []Endpoint `xml:"Endpoint"`
 }
@@ -57,10 +83,11 @@ type Endpoint struct {
 	Name      string    `xml:"name,attr"`
 	RegionIds RegionIds `xml:"RegionIds"`
 	Products  Products  `xml:"Products"`
+	Template  string    `xml:"Template"`
 }
 
 type RegionIds struct {
-	RegionId string `xml:"RegionId"`
+	RegionId []string `xml:"RegionId"`
 }
 
 type Products struct {
@@ -72,35 +99,140 @@ type Product struct {
 	DomainName  string `xml:"DomainName"`
 }
 
-func loadEndpoint(region string, serviceCode ServiceCode) string {
-	endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", string(serviceCode))))
-	if endpoint != "" {
-		return endpoint
-	}
+// endpointsDocument is the JSON and YAML format of the endpoints file, like the following:
+//
+//	endpoints:
+//	  - regions: ["cn-*"]
+//	    products:
+//	      ecs: ecs.internal
+//	    template: "{product}.{region}.example.internal"
+type endpointsDocument struct {
+	Endpoints []endpointRule `json:"endpoints" yaml:"endpoints"`
+}
+
+type endpointRule struct {
+	Regions  []string          `json:"regions" yaml:"regions"`
+	Products map[string]string `json:"products" yaml:"products"`
+	Template string            `json:"template" yaml:"template"`
+}
+
+// endpointsFiles caches the parsed endpoints files by their paths, so that each file is parsed only once.
+var endpointsFiles = struct {
+	sync.Mutex
+	rules map[string][]endpointRule
+}{rules: make(map[string][]endpointRule)}
 
-	// Load current path endpoint file endpoints.xml, if failed, it will load from environment variables TF_ENDPOINT_PATH
-	data, err := ioutil.ReadFile("./endpoints.xml")
-	if err != nil || len(data) <= 0 {
-		d, e := ioutil.ReadFile(os.Getenv("TF_ENDPOINT_PATH"))
-		if e != nil {
-			return ""
+// parseEndpointsFile parses the endpoints file in the XML, JSON or YAML format.
+func parseEndpointsFile(data []byte) ([]endpointRule, error) {
+	content := strings.TrimSpace(string(data))
+	if strings.HasPrefix(content, "<") {
+		var endpoints Endpoints
+		if err := xml.Unmarshal(data, &endpoints); err != nil {
+			return nil, err
 		}
-		data = d
+		var rules []endpointRule
+		for _, endpoint := range endpoints.Endpoint {
+			rule := endpointRule{Regions: endpoint.RegionIds.RegionId, Products: make(map[string]string), Template: endpoint.Template}
+			for _, product := range endpoint.Products.Product {
+				rule.Products[product.ProductName] = product.DomainName
+			}
+			rules = append(rules, rule)
+		}
+		return rules, nil
+	}
+	var document endpointsDocument
+	var err error
+	if strings.HasPrefix(content, "{") {
+		err = json.Unmarshal(data, &document)
+	} else {
+		err = yaml.Unmarshal(data, &document)
+	}
+	return document.Endpoints, err
+}
+
+// loadEndpointRules returns the rules of the endpoints file, which is ./endpoints.xml or the file specified by the
+// environment variable TF_ENDPOINT_PATH.
+func loadEndpointRules() []endpointRule {
+	filePath := "./endpoints.xml"
+	if _, err := os.Stat(filePath); err != nil {
+		filePath = os.Getenv("TF_ENDPOINT_PATH")
+	}
+	if filePath == "" {
+		return nil
+	}
+
+	endpointsFiles.Lock()
+	defer endpointsFiles.Unlock()
+	if rules, ok := endpointsFiles.rules[filePath]; ok {
+		return rules
+	}
+	data, err := ioutil.ReadFile(filePath)
+	var rules []endpointRule
+	if err == nil {
+		rules, err = parseEndpointsFile(data)
 	}
-	var endpoints Endpoints
-	err = xml.Unmarshal(data, &endpoints)
 	if err != nil {
-		return ""
+		log.Printf("[WARN] Loading the endpoints file %s got an error: %s", filePath, err)
 	}
-	for _, endpoint := range endpoints.Endpoint {
-		if endpoint.RegionIds.RegionId == string(region) {
-			for _, product := range endpoint.Products.Product {
-				if strings.ToLower(product.ProductName) == strings.ToLower(string(serviceCode)) {
-					return strings.TrimSpace(product.DomainName)
+	endpointsFiles.rules[filePath] = rules
+	return rules
+}
+
+// resolveEndpointRules returns the endpoint of the product in the region. The rules matching the region exactly are
+// preferred to the ones matching it by wildcards, and the domain of the product is preferred to the template.
+func resolveEndpointRules(rules []endpointRule, region string, serviceCode ServiceCode) string {
+	product := strings.ToLower(string(serviceCode))
+	for _, exact := range []bool{true, false} {
+		for _, rule := range rules {
+			if !rule.matchRegion(region, exact) {
+				continue
+			}
+			for name, domain := range rule.Products {
+				if strings.ToLower(name) == product && strings.TrimSpace(domain) != "" {
+					return strings.TrimSpace(domain)
 				}
 			}
 		}
+		for _, rule := range rules {
+			if rule.Template != "" && rule.matchRegion(region, exact) {
+				return strings.NewReplacer("{product}", product, "{region}", region).Replace(strings.TrimSpace(rule.Template))
+			}
+		}
 	}
-
 	return ""
 }
+
+func (rule endpointRule) matchRegion(region string, exact bool) bool {
+	for _, pattern := range rule.Regions {
+		pattern = strings.TrimSpace(pattern)
+		if exact && pattern == region {
+			return true
+		}
+		if !exact {
+			if matched, _ := path.Match(pattern, region); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// loadEndpoint returns the endpoint of the product in the region from the environment variable <SERVICE_CODE>_ENDPOINT
+// or the endpoints file. It is used by Config.resolveEndpoint only.
+func loadEndpoint(region string, serviceCode ServiceCode) string {
+	endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", string(serviceCode))))
+	if endpoint != "" {
+		return endpoint
+	}
+	return resolveEndpointRules(loadEndpointRules(), region, serviceCode)
+}
+
+// resolveEndpoint is the only way to find the endpoint of a product. The endpoints of the provider block are
+// preferred, and then loadEndpoint is used. It returns an empty string if the endpoint is not specified anywhere,
+// and in this case the SDK or the product default is used.
+func (c *Config) resolveEndpoint(serviceCode ServiceCode) string {
+	if endpoint := strings.TrimSpace(c.Endpoints[serviceCode]); endpoint != "" {
+		return endpoint
+	}
+	return loadEndpoint(c.RegionId, serviceCode)
+}
@@ -0,0 +1,71 @@
+package connectivity
+
+import (
+	"testing"
+)
+
+func TestResolveEndpointRules(t *testing.T) {
+	documents := map[string]string{
+		"xml": `<Endpoints>
+  <Endpoint name="hangzhou">
+    <RegionIds><RegionId>cn-hangzhou</RegionId></RegionIds>
+    <Products>
+      <Product><ProductName>Ecs</ProductName><DomainName>ecs.hangzhou.internal</DomainName></Product>
+    </Products>
+  </Endpoint>
+  <Endpoint name="private">
+    <RegionIds><RegionId>cn-*</RegionId><RegionId>private-1</RegionId></RegionIds>
+    <Products>
+      <Product><ProductName>Vpc</ProductName><DomainName>vpc.internal</DomainName></Product>
+    </Products>
+    <Template>{product}.{region}.example.internal</Template>
+  </Endpoint>
+</Endpoints>`,
+		"json": `{"endpoints": [
+  {"regions": ["cn-hangzhou"], "products": {"ecs": "ecs.hangzhou.internal"}},
+  {"regions": ["cn-*", "private-1"], "products": {"vpc": "vpc.internal"}, "template": "{product}.{region}.example.internal"}
+]}`,
+		"yaml": `endpoints:
+  - regions: [cn-hangzhou]
+    products:
+      ecs: ecs.hangzhou.internal
+  - regions: ["cn-*", private-1]
+    products:
+      vpc: vpc.internal
+    template: "{product}.{region}.example.internal"
+`,
+	}
+	cases := []struct {
+		region   string
+		code     ServiceCode
+		expected string
+	}{
+		{"cn-hangzhou", ECSCode, "ecs.hangzhou.internal"},
+		{"cn-hangzhou", VPCCode, "vpc.internal"},
+		{"cn-hangzhou", SLBCode, "slb.cn-hangzhou.example.internal"},
+		{"cn-beijing", ECSCode, "ecs.cn-beijing.example.internal"},
+		{"private-1", RDSCode, "rds.private-1.example.internal"},
+		{"us-west-1", ECSCode, ""},
+	}
+	for format, document := range documents {
+		rules, err := parseEndpointsFile([]byte(document))
+		if err != nil {
+			t.Fatalf("parsing the %s document got an error: %s", format, err)
+		}
+		for _, c := range cases {
+			if endpoint := resolveEndpointRules(rules, c.region, c.code); endpoint != c.expected {
+				t.Fatalf("%s: expected the endpoint of %s in %s to be %q, got %q", format, c.code, c.region, c.expected, endpoint)
+			}
+		}
+	}
+}
+
+func TestConfigResolveEndpoint(t *testing.T) {
+	config := &Config{RegionId: "cn-hangzhou", Endpoints: map[ServiceCode]string{ONSCode: "ons.internal"}}
+	if endpoint := config.resolveEndpoint(ONSCode); endpoint != "ons.internal" {
+		t.Fatalf("the endpoint of the provider block is expected, got %q", endpoint)
+	}
+	if endpoint := config.resolveEndpoint(OSSCode); endpoint != "" {
+		t.Fatalf("no endpoint is expected for OSS, got %q", endpoint)
+	}
+}
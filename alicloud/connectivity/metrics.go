@@ -0,0 +1,385 @@
+package connectivity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MetricsFormat is the format of the API metrics report.
+type MetricsFormat string
+
+const (
+	MetricsFormatJson       = MetricsFormat("json")
+	MetricsFormatPrometheus = MetricsFormat("prometheus")
+)
+
+// The report is rewritten a while after the API calls, because Terraform may kill the provider process without
+// letting it exit, and once more when the provider is stopped or the process exits.
+const metricsFlushDelay = 2 * time.Second
+
+// A lock of the report file older than this is left by a killed process, and is taken over.
+const metricsLockTimeout = 10 * time.Second
+
+// ApiMetric accumulates the API calls of a product action or of a resource type.
+type ApiMetric struct {
+	Product      ServiceCode `json:"product,omitempty"`
+	Action       string      `json:"action,omitempty"`
+	ResourceType string      `json:"resource_type,omitempty"`
+	Calls        int         `json:"calls"`
+	Errors       int         `json:"errors"`
+	Throttles    int         `json:"throttles"`
+	Retries      int         `json:"retries"`
+	LatencyMs    int64       `json:"latency_ms"`
+}
+
+func (m *ApiMetric) merge(other *ApiMetric) {
+	m.Calls += other.Calls
+	m.Errors += other.Errors
+	m.Throttles += other.Throttles
+	m.Retries += other.Retries
+	m.LatencyMs += other.LatencyMs
+}
+
+func (m *ApiMetric) add(trace *ApiTrace) {
+	m.Calls++
+	if trace.Error != "" {
+		m.Errors++
+	}
+	m.Throttles += trace.throttles
+	m.Retries += trace.Retries
+	m.LatencyMs += trace.LatencyMs
+}
+
+// MetricsReport is the JSON report of the API calls made by a provider process, or by all of the processes which
+// write the same report file, whose IDs are in Pids.
+type MetricsReport struct {
+	Pid       int          `json:"pid,omitempty"`
+	Pids      []int        `json:"pids,omitempty"`
+	StartedAt time.Time    `json:"started_at"`
+	UpdatedAt time.Time    `json:"updated_at"`
+	Actions   []*ApiMetric `json:"actions"`
+	Resources []*ApiMetric `json:"resources"`
+}
+
+type apiMetricsKey struct {
+	product ServiceCode
+	action  string
+}
+
+// apiMetrics collects the metrics of the API calls, and the providers configured in the same process with the same
+// report file share it.
+type apiMetrics struct {
+	mutex     sync.Mutex
+	path      string
+	format    MetricsFormat
+	startedAt time.Time
+	actions   map[apiMetricsKey]*ApiMetric
+	resources map[string]*ApiMetric
+	flushing  bool
+	// writeMutex serializes the writes of the report file.
+	writeMutex sync.Mutex
+}
+
+var metricsReports = make(map[string]*apiMetrics)
+var metricsReportsMutex = sync.Mutex{}
+
+func newApiMetrics(path string, format MetricsFormat) (*apiMetrics, error) {
+	if format == "" {
+		format = MetricsFormatJson
+	}
+	if format != MetricsFormatJson && format != MetricsFormatPrometheus {
+		return nil, fmt.Errorf("Invalid metrics report format %s. Expected %s or %s.", format, MetricsFormatJson, MetricsFormatPrometheus)
+	}
+	metricsReportsMutex.Lock()
+	defer metricsReportsMutex.Unlock()
+	if metrics, ok := metricsReports[path]; ok {
+		return metrics, nil
+	}
+	metrics := &apiMetrics{
+		path:      path,
+		format:    format,
+		startedAt: time.Now(),
+		actions:   make(map[apiMetricsKey]*ApiMetric),
+		resources: make(map[string]*ApiMetric),
+	}
+	metricsReports[path] = metrics
+	return metrics, nil
+}
+
+// record adds a traced call. The calls which are not made for a resource, like validating the credential, are
+// recorded with the provider resource type.
+func (m *apiMetrics) record(trace *ApiTrace, resourceType string) {
+	if resourceType == "" {
+		resourceType = "provider"
+	}
+	trace.mutex.Lock()
+	defer trace.mutex.Unlock()
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	key := apiMetricsKey{trace.Product, trace.Action}
+	action, ok := m.actions[key]
+	if !ok {
+		action = &ApiMetric{Product: trace.Product, Action: trace.Action}
+		m.actions[key] = action
+	}
+	action.add(trace)
+	resource, ok := m.resources[resourceType]
+	if !ok {
+		resource = &ApiMetric{ResourceType: resourceType}
+		m.resources[resourceType] = resource
+	}
+	resource.add(trace)
+
+	if !m.flushing {
+		m.flushing = true
+		time.AfterFunc(metricsFlushDelay, func() {
+			m.mutex.Lock()
+			m.flushing = false
+			m.mutex.Unlock()
+			m.flush()
+		})
+	}
+}
+
+func (m *apiMetrics) report() *MetricsReport {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	report := &MetricsReport{Pid: os.Getpid(), StartedAt: m.startedAt, UpdatedAt: time.Now()}
+	for _, action := range m.actions {
+		copied := *action
+		report.Actions = append(report.Actions, &copied)
+	}
+	for _, resource := range m.resources {
+		copied := *resource
+		report.Resources = append(report.Resources, &copied)
+	}
+	report.sort()
+	return report
+}
+
+func (report *MetricsReport) sort() {
+	sort.Slice(report.Actions, func(i, j int) bool {
+		if report.Actions[i].Product != report.Actions[j].Product {
+			return report.Actions[i].Product < report.Actions[j].Product
+		}
+		return report.Actions[i].Action < report.Actions[j].Action
+	})
+	sort.Slice(report.Resources, func(i, j int) bool {
+		return report.Resources[i].ResourceType < report.Resources[j].ResourceType
+	})
+}
+
+// flush writes the report of this process to its own file in the directory <report file>.d, and then merges the
+// reports of all of the processes in the directory into the report file while holding the lock <report file>.lock.
+// Terraform runs several provider processes for a plan or an apply, and each of them adds its calls to the report.
+// Nothing is written before the first API call, so the processes which Terraform starts only to read the schema
+// never touch the report of the others.
+func (m *apiMetrics) flush() {
+	m.writeMutex.Lock()
+	defer m.writeMutex.Unlock()
+	report := m.report()
+	if len(report.Actions) == 0 {
+		return
+	}
+	if err := m.write(report); err != nil {
+		log.Printf("[WARN] Writing the API metrics report %s got an error: %s", m.path, err)
+	}
+}
+
+func (m *apiMetrics) write(report *MetricsReport) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	dir := m.path + ".d"
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	part := filepath.Join(dir, fmt.Sprintf("%d-%d.json", report.Pid, m.startedAt.UnixNano()))
+	if err := writeFileAtomically(part, data); err != nil {
+		return err
+	}
+
+	unlock, err := lockMetricsReport(m.path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	merged, err := mergeMetricsReports(dir)
+	if err != nil {
+		return err
+	}
+	if m.format == MetricsFormatPrometheus {
+		data = merged.prometheus()
+	} else if data, err = json.MarshalIndent(merged, "", "  "); err != nil {
+		return err
+	}
+	return writeFileAtomically(m.path, data)
+}
+
+// writeFileAtomically writes a temporary file and renames it, so that the file is never read partially.
+func writeFileAtomically(path string, data []byte) error {
+	tmp := fmt.Sprintf("%s.%d.tmp", path, os.Getpid())
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// lockMetricsReport creates the lock file of the report, and waits while another process holds it. It returns the
+// function releasing the lock.
+func lockMetricsReport(path string) (func(), error) {
+	lock := path + ".lock"
+	deadline := time.Now().Add(metricsLockTimeout)
+	for {
+		file, err := os.OpenFile(lock, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			file.Close()
+			return func() { os.Remove(lock) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, err
+		}
+		if info, err := os.Stat(lock); err == nil && time.Since(info.ModTime()) > metricsLockTimeout {
+			log.Printf("[WARN] Taking over the stale lock %s of the API metrics report", lock)
+			os.Remove(lock)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("the lock %s of the API metrics report is held for more than %s", lock, metricsLockTimeout)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// mergeMetricsReports adds up the reports of the processes in the directory.
+func mergeMetricsReports(dir string) (*MetricsReport, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	merged := &MetricsReport{}
+	actions := make(map[apiMetricsKey]*ApiMetric)
+	resources := make(map[string]*ApiMetric)
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var report MetricsReport
+		if err := json.Unmarshal(data, &report); err != nil {
+			log.Printf("[WARN] Skipping the invalid API metrics report %s: %s", file, err)
+			continue
+		}
+		merged.Pids = append(merged.Pids, report.Pid)
+		if merged.StartedAt.IsZero() || report.StartedAt.Before(merged.StartedAt) {
+			merged.StartedAt = report.StartedAt
+		}
+		if report.UpdatedAt.After(merged.UpdatedAt) {
+			merged.UpdatedAt = report.UpdatedAt
+		}
+		for _, m := range report.Actions {
+			key := apiMetricsKey{m.Product, m.Action}
+			if action, ok := actions[key]; ok {
+				action.merge(m)
+				continue
+			}
+			actions[key] = m
+			merged.Actions = append(merged.Actions, m)
+		}
+		for _, m := range report.Resources {
+			if resource, ok := resources[m.ResourceType]; ok {
+				resource.merge(m)
+				continue
+			}
+			resources[m.ResourceType] = m
+			merged.Resources = append(merged.Resources, m)
+		}
+	}
+	sort.Ints(merged.Pids)
+	merged.sort()
+	return merged, nil
+}
+
+// prometheus returns the report in the Prometheus text exposition format.
+func (report *MetricsReport) prometheus() []byte {
+	var buf bytes.Buffer
+	metrics := []struct {
+		name  string
+		help  string
+		value func(m *ApiMetric) string
+	}{
+		{"calls_total", "The count of the API calls.", func(m *ApiMetric) string { return fmt.Sprint(m.Calls) }},
+		{"errors_total", "The count of the failed API calls.", func(m *ApiMetric) string { return fmt.Sprint(m.Errors) }},
+		{"throttles_total", "The count of the API requests rejected by the flow control.", func(m *ApiMetric) string { return fmt.Sprint(m.Throttles) }},
+		{"retries_total", "The count of the API requests resent by the SDK.", func(m *ApiMetric) string { return fmt.Sprint(m.Retries) }},
+		{"latency_seconds_total", "The cumulative latency of the API calls.", func(m *ApiMetric) string {
+			return fmt.Sprintf("%.3f", float64(m.LatencyMs)/1000)
+		}},
+	}
+	for _, metric := range metrics {
+		name := "alicloud_api_" + metric.name
+		fmt.Fprintf(&buf, "# HELP %s %s\n# TYPE %s counter\n", name, metric.help, name)
+		for _, m := range report.Actions {
+			fmt.Fprintf(&buf, "%s{product=%q,action=%q} %s\n", name, string(m.Product), m.Action, metric.value(m))
+		}
+		name = "alicloud_resource_api_" + metric.name
+		fmt.Fprintf(&buf, "# HELP %s %s\n# TYPE %s counter\n", name, strings.TrimSuffix(metric.help, ".")+" by the resource type.", name)
+		for _, m := range report.Resources {
+			fmt.Fprintf(&buf, "%s{resource_type=%q} %s\n", name, m.ResourceType, metric.value(m))
+		}
+	}
+	return buf.Bytes()
+}
+
+// flushOnStop writes the report when the provider is stopped, because Terraform may kill the process before it exits.
+func (m *apiMetrics) flushOnStop(stop context.Context) {
+	if stop.Done() == nil {
+		return
+	}
+	go func() {
+		<-stop.Done()
+		m.flush()
+	}()
+}
+
+// FlushMetricsReports writes the API metrics reports of the providers in the process. It is called when the provider
+// process exits.
+func FlushMetricsReports() {
+	metricsReportsMutex.Lock()
+	defer metricsReportsMutex.Unlock()
+	for _, metrics := range metricsReports {
+		metrics.flush()
+	}
+}
+
+// ForResourceType returns the client whose API calls are recorded in the metrics of the Terraform resource type. It
+// is the client itself when the metrics are disabled. The clients are cached, so each type has only one client.
+func (client *AliyunClient) ForResourceType(resourceType string) *AliyunClient {
+	if client.metrics == nil || resourceType == client.resourceType {
+		return client
+	}
+	client.resourceClients.mutex.Lock()
+	defer client.resourceClients.mutex.Unlock()
+	key := client.RegionId + "/" + resourceType
+	if typed, ok := client.resourceClients.clients[key]; ok {
+		return typed
+	}
+	typed := client.config.newClient()
+	client.share(typed)
+	typed.regionValues = client.regionValues
+	typed.resourceType = resourceType
+	client.resourceClients.clients[key] = typed
+	return typed
+}
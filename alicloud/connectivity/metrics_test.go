@@ -0,0 +1,123 @@
+package connectivity
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestApiMetricsReport(t *testing.T) {
+	dir := t.TempDir()
+	for _, format := range []MetricsFormat{MetricsFormatJson, MetricsFormatPrometheus} {
+		path := filepath.Join(dir, "metrics."+string(format))
+		metrics, err := newApiMetrics(path, format)
+		if err != nil {
+			t.Fatal(err)
+		}
+		metrics.record(&ApiTrace{Product: ECSCode, Action: "DescribeInstances", LatencyMs: 200, Retries: 1}, "alicloud_instance")
+		metrics.record(&ApiTrace{Product: ECSCode, Action: "DescribeInstances", LatencyMs: 300, Error: "Throttling", throttles: 2}, "data.alicloud_instances")
+		metrics.record(&ApiTrace{Product: STSCode, Action: "GetCallerIdentity", LatencyMs: 100}, "")
+		metrics.flush()
+
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if format == MetricsFormatPrometheus {
+			for _, line := range []string{
+				`alicloud_api_calls_total{product="ECS",action="DescribeInstances"} 2`,
+				`alicloud_api_throttles_total{product="ECS",action="DescribeInstances"} 2`,
+				`alicloud_api_latency_seconds_total{product="ECS",action="DescribeInstances"} 0.500`,
+				`alicloud_resource_api_errors_total{resource_type="data.alicloud_instances"} 1`,
+				`alicloud_resource_api_calls_total{resource_type="provider"} 1`,
+			} {
+				if !strings.Contains(string(data), line+"\n") {
+					t.Errorf("the prometheus report is expected to contain %s, got:\n%s", line, data)
+				}
+			}
+			continue
+		}
+		var report MetricsReport
+		if err := json.Unmarshal(data, &report); err != nil {
+			t.Fatalf("the report is expected to be JSON, got %s", data)
+		}
+		if len(report.Actions) != 2 || report.Actions[0].Action != "DescribeInstances" || report.Actions[0].Calls != 2 ||
+			report.Actions[0].Errors != 1 || report.Actions[0].Retries != 1 || report.Actions[0].LatencyMs != 500 {
+			t.Errorf("unexpected action metrics %s", data)
+		}
+		if len(report.Resources) != 3 || report.Resources[0].ResourceType != "alicloud_instance" || report.Resources[0].Calls != 1 {
+			t.Errorf("unexpected resource metrics %s", data)
+		}
+	}
+
+	if _, err := newApiMetrics(filepath.Join(dir, "metrics.txt"), MetricsFormat("text")); err == nil {
+		t.Errorf("an unknown format is expected to be rejected")
+	}
+}
+
+func TestForResourceType(t *testing.T) {
+	config := &Config{RegionId: "cn-beijing"}
+	client := config.newClient()
+	if client.ForResourceType("alicloud_instance") != client {
+		t.Fatalf("the client itself is expected when the metrics are disabled")
+	}
+
+	metrics, err := newApiMetrics(filepath.Join(t.TempDir(), "metrics.json"), MetricsFormatJson)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.metrics = metrics
+	client.resourceClients = &regionClientCache{clients: make(map[string]*AliyunClient)}
+	typed := client.ForResourceType("alicloud_instance")
+	if typed == client || typed.resourceType != "alicloud_instance" || typed.metrics != metrics || typed.RegionId != "cn-beijing" {
+		t.Fatalf("unexpected client of the resource type: %#v", typed)
+	}
+	if client.ForResourceType("alicloud_instance") != typed || typed.ForResourceType("alicloud_instance") != typed {
+		t.Fatalf("the client of the resource type is expected to be cached")
+	}
+}
+
+func TestApiMetricsMergeProcesses(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	first, err := newApiMetrics(path, MetricsFormatJson)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first.record(&ApiTrace{Product: ECSCode, Action: "DescribeInstances", LatencyMs: 200}, "alicloud_instance")
+	first.flush()
+
+	// Another process writing the same report file
+	second := &apiMetrics{
+		path:      path,
+		format:    MetricsFormatJson,
+		startedAt: time.Now().Add(-time.Minute),
+		actions:   make(map[apiMetricsKey]*ApiMetric),
+		resources: make(map[string]*ApiMetric),
+	}
+	second.record(&ApiTrace{Product: ECSCode, Action: "DescribeInstances", LatencyMs: 300}, "alicloud_instance")
+	second.record(&ApiTrace{Product: VPCCode, Action: "DescribeVpcs", LatencyMs: 100}, "alicloud_vpc")
+	stop, cancel := context.WithCancel(context.Background())
+	second.flushOnStop(stop)
+	cancel()
+
+	var report MetricsReport
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		data, _ := ioutil.ReadFile(path)
+		report = MetricsReport{}
+		if json.Unmarshal(data, &report) == nil && len(report.Pids) == 2 {
+			break
+		}
+	}
+	if len(report.Pids) != 2 || len(report.Actions) != 2 || report.Actions[0].Calls != 2 || report.Actions[0].LatencyMs != 500 ||
+		report.Actions[1].Action != "DescribeVpcs" || len(report.Resources) != 2 || report.Resources[0].Calls != 2 {
+		t.Fatalf("the reports of the processes are expected to be merged when the provider stops, got %#v", report)
+	}
+	if _, err := os.Stat(path + ".lock"); !os.IsNotExist(err) {
+		t.Fatalf("the lock is expected to be released")
+	}
+}
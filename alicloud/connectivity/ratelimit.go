@@ -0,0 +1,192 @@
+package connectivity
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The QPS limit of the products which are not set by defaultRateLimits or `api_rate_limits`.
+const DefaultRateLimitQps = 50
+
+// The products whose APIs are most likely to be throttled when applying hundreds of resources.
+var defaultRateLimits = map[ServiceCode]float64{
+	ECSCode: 20,
+	VPCCode: 20,
+	SLBCode: 20,
+	RDSCode: 20,
+	ESSCode: 20,
+}
+
+// RateLimit limits the QPS of the API requests of a product, or of one API action when Action is set.
+// A Qps of 0 disables the limit.
+type RateLimit struct {
+	Product ServiceCode
+	Action  string
+	Qps     float64
+	Burst   int
+}
+
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	mutex  sync.Mutex
+}
+
+func newTokenBucket(qps float64, burst int) *tokenBucket {
+	if burst <= 0 {
+		burst = int(qps)
+		if burst < 1 {
+			burst = 1
+		}
+	}
+	return &tokenBucket{
+		rate:   qps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller has to wait for it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// wait blocks until the token is available, or returns at once when the provider is stopped, and then the request is
+// not sent by invokeWithStop.
+func (b *tokenBucket) wait(stop context.Context) {
+	if delay := b.reserve(); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-stop.Done():
+		case <-timer.C:
+		}
+	}
+}
+
+type rateLimiter struct {
+	limits  map[string]RateLimit
+	buckets map[string]*tokenBucket
+	stop    context.Context
+	mutex   sync.Mutex
+}
+
+func newRateLimiter(limits []RateLimit, stop context.Context) *rateLimiter {
+	limiter := &rateLimiter{
+		limits:  make(map[string]RateLimit),
+		buckets: make(map[string]*tokenBucket),
+		stop:    stop,
+	}
+	for code, qps := range defaultRateLimits {
+		limiter.limits[rateLimitKey(code, "")] = RateLimit{Product: code, Qps: qps}
+	}
+	for _, limit := range limits {
+		limiter.limits[rateLimitKey(limit.Product, limit.Action)] = limit
+	}
+	return limiter
+}
+
+func rateLimitKey(code ServiceCode, action string) string {
+	key := strings.ToUpper(string(code))
+	if action != "" {
+		key += "." + strings.ToLower(action)
+	}
+	return key
+}
+
+func (l *rateLimiter) bucket(key string, limit RateLimit) *tokenBucket {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = newTokenBucket(limit.Qps, limit.Burst)
+		l.buckets[key] = bucket
+	}
+	return bucket
+}
+
+// waitProduct blocks until a request of the product is allowed.
+func (l *rateLimiter) waitProduct(code ServiceCode) {
+	if l == nil {
+		return
+	}
+	key := rateLimitKey(code, "")
+	limit, ok := l.limits[key]
+	if !ok {
+		limit = RateLimit{Product: code, Qps: DefaultRateLimitQps}
+	}
+	if limit.Qps > 0 {
+		l.bucket(key, limit).wait(l.stop)
+	}
+}
+
+// waitAction blocks until a request of the API action of the product is allowed.
+func (l *rateLimiter) waitAction(code ServiceCode, action string) {
+	key := rateLimitKey(code, action)
+	if limit, ok := l.limits[key]; ok && limit.Qps > 0 {
+		l.bucket(key, limit).wait(l.stop)
+	}
+}
+
+func (l *rateLimiter) hasActionLimits(code ServiceCode) bool {
+	for _, limit := range l.limits {
+		if limit.Action != "" && strings.EqualFold(string(limit.Product), string(code)) {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimitTransport limits the requests of the product SDK client per API action.
+type rateLimitTransport struct {
+	limiter *rateLimiter
+	code    ServiceCode
+	next    http.RoundTripper
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	action, err := requestAction(req)
+	if err != nil {
+		return nil, err
+	}
+	if action != "" {
+		t.limiter.waitAction(t.code, action)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// requestAction returns the API action of a request of the product SDK clients, which is in the query or in the form
+// body.
+func requestAction(req *http.Request) (string, error) {
+	action := req.URL.Query().Get("Action")
+	if action == "" {
+		body, err := readRequestBody(req)
+		if err != nil {
+			return "", err
+		}
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			action = values.Get("Action")
+		}
+	}
+	return action, nil
+}
@@ -0,0 +1,62 @@
+package connectivity
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	bucket := newTokenBucket(10, 2)
+	if bucket.reserve() != 0 || bucket.reserve() != 0 {
+		t.Fatalf("the burst requests are expected to be sent without waiting")
+	}
+	if delay := bucket.reserve(); delay <= 0 || delay > 100*time.Millisecond {
+		t.Fatalf("the 3rd request is expected to wait at most 100ms, got %s", delay)
+	}
+}
+
+func TestTokenBucketWaitStopped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	bucket := newTokenBucket(0.1, 1)
+	bucket.reserve()
+
+	start := time.Now()
+	bucket.wait(ctx)
+	if time.Since(start) > time.Second {
+		t.Fatalf("the wait for a token is expected to return at once when the provider is stopped")
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	limiter := newRateLimiter([]RateLimit{
+		{Product: ECSCode, Qps: 0},
+		{Product: "vpc", Action: "DescribeVpcs", Qps: 1, Burst: 1},
+	}, context.Background())
+	if _, ok := limiter.limits["ECS"]; !ok || limiter.limits["ECS"].Qps != 0 {
+		t.Fatalf("the default limit of ECS is expected to be overridden")
+	}
+	if !limiter.hasActionLimits(VPCCode) || limiter.hasActionLimits(ECSCode) {
+		t.Fatalf("only VPC is expected to have action limits")
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	transport := &rateLimitTransport{limiter: limiter, code: VPCCode, next: &http.Transport{}}
+	client := &http.Client{Transport: transport}
+
+	start := time.Now()
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL + "/?Action=DescribeVpcs")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 900*time.Millisecond {
+		t.Fatalf("the 2nd DescribeVpcs request is expected to wait for about 1s, got %s", elapsed)
+	}
+}
@@ -0,0 +1,455 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"github.com/dxh031/ali_mns"
+	"github.com/valyala/fasthttp"
+)
+
+// RecordMode decides whether the API exchanges of an AliyunClient are captured to a cassette file
+// or served from it. It is mainly used to run the acceptance tests without network.
+type RecordMode string
+
+const (
+	RecordModeNone   = RecordMode("")
+	RecordModeRecord = RecordMode("record")
+	RecordModeReplay = RecordMode("replay")
+)
+
+// These request fields change on every call or carry credentials. They are removed before
+// a request is written to a cassette or matched against it.
+var volatileRequestFields = []string{
+	"Signature", "SignatureNonce", "Timestamp", "AccessKeyId", "SecurityToken", "ClientToken",
+	"Expires", "OSSAccessKeyId", "Date", "Authorization", "x-acs-signature-nonce", "x-acs-security-token",
+	"x-oss-security-token", "x-log-date", "x-fc-date", "x-mns-date",
+}
+
+// These patterns match the values generated anew by each test run: the names with a random suffix, the client tokens
+// and the UUIDs. They are masked when a request is matched against a cassette, and any other difference fails the match.
+var randomValuePatterns = []struct {
+	pattern *regexp.Regexp
+	mask    string
+}{
+	{regexp.MustCompile(`TF-[A-Za-z]+-[0-9]{10}-[-0-9a-f]+`), "<token>"},
+	{regexp.MustCompile(`[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}`), "<uuid>"},
+	{regexp.MustCompile(`(?i)(tf[-_]?test[-_.a-z]*?)[0-9]{4,}`), "${1}<random>"},
+}
+
+// Interaction is a single request and response pair stored in a cassette.
+type Interaction struct {
+	Request  CassetteRequest  `json:"request"`
+	Response CassetteResponse `json:"response"`
+}
+
+type CassetteRequest struct {
+	Method string `json:"method"`
+	Url    string `json:"url"`
+	Body   string `json:"body"`
+}
+
+type CassetteResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+}
+
+// Cassette holds the interactions of one test case and persists them as a json file.
+type Cassette struct {
+	Path         string         `json:"-"`
+	Interactions []*Interaction `json:"interactions"`
+
+	used  []bool
+	mutex sync.Mutex
+}
+
+var cassettes = make(map[string]*Cassette)
+var cassettesMutex = sync.Mutex{}
+
+// loadCassette returns the cassette stored in path. Several providers configured in the same process share one cassette,
+// so a record run only truncates the file the first time it is opened.
+func loadCassette(path string, mode RecordMode) (*Cassette, error) {
+	cassettesMutex.Lock()
+	defer cassettesMutex.Unlock()
+
+	if cassette, ok := cassettes[path]; ok {
+		return cassette, nil
+	}
+	cassette := &Cassette{Path: path}
+	if mode == RecordModeReplay {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading cassette %s got an error: %#v", path, err)
+		}
+		if err := json.Unmarshal(data, cassette); err != nil {
+			return nil, fmt.Errorf("parsing cassette %s got an error: %#v", path, err)
+		}
+		cassette.used = make([]bool, len(cassette.Interactions))
+	}
+	cassettes[path] = cassette
+	return cassette, nil
+}
+
+func (c *Cassette) save() error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.Path, data, 0644)
+}
+
+func (c *Cassette) add(interaction *Interaction) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.Interactions = append(c.Interactions, interaction)
+	c.used = append(c.used, true)
+	return c.save()
+}
+
+// find returns the first unused interaction which matches the request once their random values are masked. If there
+// is none, it returns an error describing the unused interaction calling the same API, if any, to show the difference.
+func (c *Cassette) find(request CassetteRequest) (*Interaction, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	masked := maskRequest(request)
+	var mismatched *Interaction
+	for i, interaction := range c.Interactions {
+		if c.used[i] {
+			continue
+		}
+		if maskRequest(interaction.Request) == masked {
+			c.used[i] = true
+			return interaction, nil
+		}
+		if mismatched == nil && apiOf(interaction.Request) == apiOf(request) {
+			mismatched = interaction
+		}
+	}
+	if mismatched != nil {
+		return nil, fmt.Errorf("the request %s %s %s does not match the next recorded one %s %s %s in cassette %s",
+			request.Method, request.Url, request.Body, mismatched.Request.Method, mismatched.Request.Url, mismatched.Request.Body, c.Path)
+	}
+	return nil, fmt.Errorf("there is no recorded interaction for %s %s in cassette %s", request.Method, request.Url, c.Path)
+}
+
+func maskRequest(request CassetteRequest) CassetteRequest {
+	for _, random := range randomValuePatterns {
+		request.Url = random.pattern.ReplaceAllString(request.Url, random.mask)
+		request.Body = random.pattern.ReplaceAllString(request.Body, random.mask)
+	}
+	return request
+}
+
+// apiOf identifies the API called by a request: the method, the host, the path and the RPC action if there is one.
+func apiOf(request CassetteRequest) string {
+	u, err := url.Parse(request.Url)
+	if err != nil {
+		return request.Method + " " + request.Url
+	}
+	action := u.Query().Get("Action")
+	if action == "" {
+		if values, err := url.ParseQuery(request.Body); err == nil {
+			action = values.Get("Action")
+		}
+	}
+	return fmt.Sprintf("%s %s%s %s", request.Method, u.Host, u.Path, action)
+}
+
+// recorder is a http.RoundTripper which captures or replays API exchanges.
+type recorder struct {
+	mode      RecordMode
+	cassette  *Cassette
+	transport http.RoundTripper
+
+	loopbackOnce sync.Once
+	loopbackAddr string
+	loopbackErr  error
+}
+
+func newRecorder(mode RecordMode, path string, proxyUrl *url.URL) (*recorder, error) {
+	if mode != RecordModeRecord && mode != RecordModeReplay {
+		return nil, fmt.Errorf("invalid record mode %q, expected %q or %q", mode, RecordModeRecord, RecordModeReplay)
+	}
+	if path == "" {
+		return nil, fmt.Errorf("a cassette path is required in record mode %q", mode)
+	}
+	cassette, err := loadCassette(path, mode)
+	if err != nil {
+		return nil, err
+	}
+	transport := &http.Transport{}
+	if proxyUrl != nil {
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+	return &recorder{
+		mode:      mode,
+		cassette:  cassette,
+		transport: transport,
+	}, nil
+}
+
+func (r *recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	request := normalizeRequest(req, body)
+
+	if r.mode == RecordModeReplay {
+		interaction, err := r.cassette.find(request)
+		if err != nil {
+			return nil, err
+		}
+		return buildResponse(req, interaction.Response), nil
+	}
+
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	response := CassetteResponse{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       string(respBody),
+	}
+	if err := r.cassette.add(&Interaction{Request: request, Response: redactResponse(response)}); err != nil {
+		log.Printf("[WARN] Saving cassette %s got an error: %#v", r.cassette.Path, err)
+	}
+	return buildResponse(req, response), nil
+}
+
+// hook routes all requests sent by the transport to the recorder.
+func (r *recorder) hook(transport *http.Transport) {
+	transport.RegisterProtocol("http", r)
+	transport.RegisterProtocol("https", r)
+}
+
+// hookHttpClient makes the SDK client send its requests by the recorder. The SDKs which neither expose their http
+// client nor accept a transport keep it in the unexported field, which is replaced by reflection. Only this SDK client
+// is changed, so that the other clients in the process are not affected.
+func (r *recorder) hookHttpClient(sdkClient interface{}, field string) error {
+	value := reflect.ValueOf(sdkClient)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unsupported SDK client type %T", sdkClient)
+	}
+	httpClient := value.Elem().FieldByName(field)
+	if !httpClient.IsValid() || httpClient.Type() != reflect.TypeOf(&http.Client{}) {
+		return fmt.Errorf("unsupported SDK client type %T", sdkClient)
+	}
+	hooked := &http.Client{Transport: r}
+	if !httpClient.IsNil() {
+		hooked.Timeout = (*http.Client)(unsafe.Pointer(httpClient.Pointer())).Timeout
+	}
+	reflect.NewAt(httpClient.Type(), unsafe.Pointer(httpClient.UnsafeAddr())).Elem().Set(reflect.ValueOf(hooked))
+	return nil
+}
+
+// hookMnsClient redirects the fasthttp connections of a MNS client to a loopback listener served by the recorder.
+// The MNS SDK neither exposes its http client nor supports a proxy, so the dial function is set by reflection.
+func (r *recorder) hookMnsClient(mnsClient ali_mns.MNSClient) error {
+	r.loopbackOnce.Do(func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			r.loopbackErr = err
+			return
+		}
+		r.loopbackAddr = listener.Addr().String()
+		go http.Serve(listener, http.HandlerFunc(r.serveLoopback))
+	})
+	if r.loopbackErr != nil {
+		return fmt.Errorf("starting the recorder loopback listener got an error: %#v", r.loopbackErr)
+	}
+
+	value := reflect.ValueOf(mnsClient)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unsupported MNS client type %T", mnsClient)
+	}
+	field := value.Elem().FieldByName("client")
+	if !field.IsValid() || field.Type() != reflect.TypeOf(&fasthttp.Client{}) {
+		return fmt.Errorf("unsupported MNS client type %T", mnsClient)
+	}
+	fastClient := (*fasthttp.Client)(unsafe.Pointer(field.Pointer()))
+	fastClient.Dial = func(addr string) (net.Conn, error) {
+		return net.Dial("tcp", r.loopbackAddr)
+	}
+	return nil
+}
+
+func (r *recorder) serveLoopback(w http.ResponseWriter, req *http.Request) {
+	outReq := req.WithContext(req.Context())
+	outReq.RequestURI = ""
+	outReq.URL = &url.URL{
+		Scheme:   "https",
+		Host:     req.Host,
+		Path:     req.URL.Path,
+		RawQuery: req.URL.RawQuery,
+	}
+	resp, err := r.RoundTrip(outReq)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	for key, values := range resp.Header {
+		for _, v := range values {
+			w.Header().Add(key, v)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	body, _ := ioutil.ReadAll(resp.Body)
+	w.Write(body)
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// normalizeRequest removes signatures, nonces, timestamps and credentials from a request, redacts its sensitive
+// parameters and sorts them, so that the same API call always produces the same cassette request.
+func normalizeRequest(req *http.Request, body []byte) CassetteRequest {
+	u := *req.URL
+	u.User = nil
+	u.RawQuery = normalizeValues(u.Query())
+
+	normalizedBody := string(body)
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(normalizedBody); err == nil {
+			normalizedBody = normalizeValues(values)
+		}
+	} else {
+		normalizedBody = redactJsonBody(normalizedBody)
+	}
+	return CassetteRequest{
+		Method: req.Method,
+		Url:    u.String(),
+		Body:   normalizedBody,
+	}
+}
+
+func normalizeValues(values url.Values) string {
+	for key := range values {
+		for _, field := range volatileRequestFields {
+			if strings.EqualFold(key, field) {
+				delete(values, key)
+				break
+			}
+		}
+		if _, ok := values[key]; ok && IsRedactedField(key) {
+			for i := range values[key] {
+				values[key][i] = "******"
+			}
+		}
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var buf bytes.Buffer
+	for _, key := range keys {
+		for _, v := range values[key] {
+			if buf.Len() > 0 {
+				buf.WriteByte('&')
+			}
+			buf.WriteString(url.QueryEscape(key))
+			buf.WriteByte('=')
+			buf.WriteString(url.QueryEscape(v))
+		}
+	}
+	return buf.String()
+}
+
+// redactResponse returns a copy of the response to be written to a cassette, in which the values of the redacted
+// headers and the redacted fields of a JSON body are replaced like in a trace.
+func redactResponse(response CassetteResponse) CassetteResponse {
+	header := http.Header{}
+	for key, values := range response.Header {
+		if IsRedactedField(key) {
+			header[key] = []string{"******"}
+			continue
+		}
+		header[key] = values
+	}
+	if body := redactJsonBody(response.Body); body != response.Body {
+		header.Del("Content-Length")
+		response.Body = body
+	}
+	response.Header = header
+	return response
+}
+
+// redactJsonBody returns the body with the values of its redacted fields replaced, or the body itself if it is not
+// JSON or has none of them. The numbers are kept as they are, because the IDs may be too long for a float64.
+func redactJsonBody(body string) string {
+	trimmed := strings.TrimSpace(body)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return body
+	}
+	decoder := json.NewDecoder(strings.NewReader(trimmed))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return body
+	}
+	before, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	after, err := json.Marshal(redact(value))
+	if err != nil || bytes.Equal(before, after) {
+		return body
+	}
+	return string(after)
+}
+
+func buildResponse(req *http.Request, response CassetteResponse) *http.Response {
+	header := http.Header{}
+	for key, values := range response.Header {
+		header[key] = append([]string{}, values...)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+		StatusCode:    response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(response.Body)),
+		ContentLength: int64(len(response.Body)),
+		Request:       req,
+	}
+}
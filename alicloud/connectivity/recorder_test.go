@@ -0,0 +1,120 @@
+package connectivity
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeRequest(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://ecs.aliyuncs.com/?Action=DescribeInstances&Signature=abc&SignatureNonce=123&Timestamp=2019-08-01T00%3A00%3A00Z&AccessKeyId=key&RegionId=cn-beijing", nil)
+	request := normalizeRequest(req, nil)
+	if request.Url != "https://ecs.aliyuncs.com/?Action=DescribeInstances&RegionId=cn-beijing" {
+		t.Fatalf("unexpected normalized url %s", request.Url)
+	}
+
+	body := "SignatureNonce=456&Action=CreateVpc&ClientToken=TF-CreateVpc-1&CidrBlock=172.16.0.0%2F12"
+	req, _ = http.NewRequest("POST", "https://vpc.aliyuncs.com/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request = normalizeRequest(req, []byte(body))
+	if request.Body != "Action=CreateVpc&CidrBlock=172.16.0.0%2F12" {
+		t.Fatalf("unexpected normalized body %s", request.Body)
+	}
+
+	body = "Action=CreateInstance&Password=secret&DataDisk.1.Password=secret"
+	req, _ = http.NewRequest("POST", "https://ecs.aliyuncs.com/", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request = normalizeRequest(req, []byte(body))
+	if request.Body != "Action=CreateInstance&DataDisk.1.Password=%2A%2A%2A%2A%2A%2A&Password=%2A%2A%2A%2A%2A%2A" {
+		t.Fatalf("unexpected normalized body %s", request.Body)
+	}
+
+	body = `{"name":"tf-test","password":"secret","size":12345678901234567890}`
+	req, _ = http.NewRequest("POST", "https://cs.aliyuncs.com/clusters", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	request = normalizeRequest(req, []byte(body))
+	if request.Body != `{"name":"tf-test","password":"******","size":12345678901234567890}` {
+		t.Fatalf("unexpected normalized body %s", request.Body)
+	}
+}
+
+func TestCassetteFind(t *testing.T) {
+	cassette := &Cassette{
+		Interactions: []*Interaction{
+			{Request: CassetteRequest{Method: "GET", Url: "https://vpc.aliyuncs.com/?Action=DescribeVpcs&VpcName=tf-1"}},
+			{Request: CassetteRequest{Method: "GET", Url: "https://vpc.aliyuncs.com/?Action=DescribeVpcs&VpcName=tf-2"}},
+		},
+		used: []bool{false, false},
+	}
+
+	if i, err := cassette.find(CassetteRequest{Method: "GET", Url: "https://vpc.aliyuncs.com/?Action=DescribeVpcs&VpcName=tf-2"}); i != cassette.Interactions[1] {
+		t.Fatalf("the exactly matched interaction is expected, got error %v", err)
+	}
+	if i, err := cassette.find(CassetteRequest{Method: "GET", Url: "https://vpc.aliyuncs.com/?Action=DescribeVpcs&VpcName=tf-3"}); i != nil || err == nil {
+		t.Fatalf("a request with a different name is expected not to match")
+	}
+	if i, err := cassette.find(CassetteRequest{Method: "GET", Url: "https://vpc.aliyuncs.com/?Action=DescribeVpcs&VpcName=tf-1"}); i != cassette.Interactions[0] {
+		t.Fatalf("the exactly matched interaction is expected, got error %v", err)
+	}
+	if i, err := cassette.find(CassetteRequest{Method: "GET", Url: "https://vpc.aliyuncs.com/?Action=DescribeVpcs&VpcName=tf-1"}); i != nil || err == nil {
+		t.Fatalf("all interactions have been used")
+	}
+
+	cassette = &Cassette{
+		Interactions: []*Interaction{
+			{Request: CassetteRequest{Method: "POST", Url: "https://vpc.aliyuncs.com/", Body: "Action=CreateVpc&VpcName=tf-testAccVpc-1234"}},
+		},
+		used: []bool{false},
+	}
+	if i, err := cassette.find(CassetteRequest{Method: "POST", Url: "https://vpc.aliyuncs.com/", Body: "Action=CreateVpc&VpcName=tf-testAccVpc-56789"}); i != cassette.Interactions[0] {
+		t.Fatalf("the random suffixes of the names are expected to be masked, got error %v", err)
+	}
+}
+
+func TestRecorderRecordAndReplay(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Acs-Security-Token", "token")
+		w.Write([]byte(`{"RequestId":"` + r.URL.Query().Get("Action") + `","AccessKeySecret":"secret"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	record, err := newRecorder(RecordModeRecord, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport := &http.Transport{}
+	record.hook(transport)
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL + "/?Action=DescribeRegions&Signature=abc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	server.Close()
+	if data, _ := ioutil.ReadFile(path); strings.Contains(string(data), "secret") || strings.Contains(string(data), `"token"`) {
+		t.Fatalf("the credentials are expected to be redacted from the cassette %s", data)
+	}
+
+	// Replay a fresh copy of the cassette from disk without any network
+	cassettesMutex.Lock()
+	delete(cassettes, path)
+	cassettesMutex.Unlock()
+	replay, err := newRecorder(RecordModeReplay, path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport = &http.Transport{}
+	replay.hook(transport)
+	resp, err = (&http.Client{Transport: transport}).Get(server.URL + "/?Action=DescribeRegions&Signature=def")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != `{"AccessKeySecret":"******","RequestId":"DescribeRegions"}` {
+		t.Fatalf("unexpected replayed body %s", body)
+	}
+}
@@ -0,0 +1,288 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/drds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/gpdb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+)
+
+// The discovered regions are cached on disk for a day, and the stale ones are still used when DescribeRegions fails.
+const regionCacheTTL = 24 * time.Hour
+
+// productFallbackRegions are the static regions of the products, which are used when their regions cannot be
+// discovered. ValidRegions is used for the products not in it.
+var productFallbackRegions = map[ServiceCode][]Region{
+	DRDSCode:    DrdsSupportedRegions,
+	GPDBCode:    GpdbSupportedRegions,
+	DATAHUBCode: DatahubSupportedRegions,
+	DDOSCOOCode: DdoscooSupportedRegions,
+}
+
+// DefaultRegionCacheFile returns the default path of the on-disk region cache.
+func DefaultRegionCacheFile() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "terraform-provider-alicloud", "regions.json")
+}
+
+type regionCacheEntry struct {
+	RegionIds []string  `json:"region_ids"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// regionIdCache holds the discovered regions of the products, and it is shared by the clients of all regions.
+type regionIdCache struct {
+	mutex   sync.Mutex
+	path    string
+	entries map[ServiceCode]regionCacheEntry
+	// calls are the in-flight lookups of the products, which the concurrent lookups of the same product wait for.
+	calls map[ServiceCode]*regionIdCall
+	// file serializes reading and writing the on-disk cache by the lookups of the different products.
+	file sync.Mutex
+	// discover is the DescribeRegions call of the product, and it is replaced in tests.
+	discover func(product ServiceCode) ([]string, error)
+}
+
+type regionIdCall struct {
+	done      chan struct{}
+	regionIds []string
+}
+
+func newRegionIdCache(path string) *regionIdCache {
+	return &regionIdCache{path: path, entries: make(map[ServiceCode]regionCacheEntry), calls: make(map[ServiceCode]*regionIdCall)}
+}
+
+func (c *regionIdCache) load() map[ServiceCode]regionCacheEntry {
+	entries := make(map[ServiceCode]regionCacheEntry)
+	if c.path == "" {
+		return entries
+	}
+	data, err := ioutil.ReadFile(c.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("[WARN] Reading the region cache %s got an error: %s", c.path, err)
+		}
+		return entries
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		log.Printf("[WARN] Parsing the region cache %s got an error: %s", c.path, err)
+	}
+	return entries
+}
+
+func (c *regionIdCache) loadEntry(product ServiceCode) (regionCacheEntry, bool) {
+	c.file.Lock()
+	defer c.file.Unlock()
+	entry, ok := c.load()[product]
+	return entry, ok
+}
+
+func (c *regionIdCache) save(product ServiceCode, entry regionCacheEntry) {
+	if c.path == "" {
+		return
+	}
+	c.file.Lock()
+	defer c.file.Unlock()
+	entries := c.load()
+	entries[product] = entry
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(c.path), 0755)
+	}
+	if err == nil {
+		// Write a temporary file and rename it, so that the concurrent runs never read a partial file.
+		tmp := fmt.Sprintf("%s.%d", c.path, os.Getpid())
+		if err = ioutil.WriteFile(tmp, data, 0644); err == nil {
+			err = os.Rename(tmp, c.path)
+		}
+	}
+	if err != nil {
+		log.Printf("[WARN] Writing the region cache %s got an error: %s", c.path, err)
+	}
+}
+
+// DescribeRegionIds returns the regions in which the product is available. They are discovered by the DescribeRegions
+// API of the product and cached in memory and on disk. When the API is unavailable, the stale cache or the static
+// regions of the product are returned instead, so it never fails. The concurrent lookups of a product share one call
+// of the API, and the lookups of the other products are not blocked by it.
+func (client *AliyunClient) DescribeRegionIds(product ServiceCode) []string {
+	cache := client.regionIds
+	cache.mutex.Lock()
+	if entry, ok := cache.entries[product]; ok {
+		cache.mutex.Unlock()
+		return entry.RegionIds
+	}
+	if call, ok := cache.calls[product]; ok {
+		cache.mutex.Unlock()
+		<-call.done
+		return call.regionIds
+	}
+	call := &regionIdCall{done: make(chan struct{})}
+	cache.calls[product] = call
+	cache.mutex.Unlock()
+
+	entry := client.lookupRegionIds(product)
+
+	cache.mutex.Lock()
+	cache.entries[product] = entry
+	delete(cache.calls, product)
+	cache.mutex.Unlock()
+	call.regionIds = entry.RegionIds
+	close(call.done)
+	return entry.RegionIds
+}
+
+// lookupRegionIds reads the regions of the product from the on-disk cache, or discovers them when the cache is stale.
+func (client *AliyunClient) lookupRegionIds(product ServiceCode) regionCacheEntry {
+	cache := client.regionIds
+	stale, ok := cache.loadEntry(product)
+	if ok && time.Since(stale.UpdatedAt) < regionCacheTTL && len(stale.RegionIds) > 0 {
+		return stale
+	}
+
+	discover := cache.discover
+	if discover == nil {
+		discover = client.discoverRegionIds
+	}
+	regionIds, err := discover(product)
+	if err == nil && len(regionIds) > 0 {
+		entry := regionCacheEntry{RegionIds: regionIds, UpdatedAt: time.Now()}
+		cache.save(product, entry)
+		return entry
+	}
+	if ok && len(stale.RegionIds) > 0 {
+		log.Printf("[WARN] Discovering the regions of %s got an error: %v. Using the regions cached at %s.", product, err, stale.UpdatedAt.Format(time.RFC3339))
+		return stale
+	}
+	if err != nil {
+		log.Printf("[WARN] Discovering the regions of %s got an error: %v. Using the built-in regions.", product, err)
+	}
+	regions, ok := productFallbackRegions[product]
+	if !ok {
+		regions = ValidRegions
+	}
+	for _, region := range regions {
+		regionIds = append(regionIds, string(region))
+	}
+	return regionCacheEntry{RegionIds: regionIds}
+}
+
+// IsRegionSupported reports whether the product is available in the region.
+func (client *AliyunClient) IsRegionSupported(product ServiceCode, regionId string) bool {
+	for _, id := range client.DescribeRegionIds(product) {
+		if id == regionId {
+			return true
+		}
+	}
+	return false
+}
+
+func (client *AliyunClient) validateRegionId(regionId string) error {
+	if client.IsRegionSupported(ECSCode, regionId) {
+		return nil
+	}
+	return fmt.Errorf("Invalid Alibaba Cloud region: %s. Expected on %s. Set skip_region_validation to skip validating it.",
+		regionId, strings.Join(client.DescribeRegionIds(ECSCode), ", "))
+}
+
+// discoverRegionIds calls the DescribeRegions API of the product. It returns nil for the products without the API.
+func (client *AliyunClient) discoverRegionIds(product ServiceCode) ([]string, error) {
+	var raw interface{}
+	var err error
+	switch product {
+	case ECSCode:
+		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeRegions(ecs.CreateDescribeRegionsRequest())
+		})
+	case VPCCode:
+		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeRegions(vpc.CreateDescribeRegionsRequest())
+		})
+	case SLBCode:
+		raw, err = client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
+			return slbClient.DescribeRegions(slb.CreateDescribeRegionsRequest())
+		})
+	case RDSCode:
+		raw, err = client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return rdsClient.DescribeRegions(rds.CreateDescribeRegionsRequest())
+		})
+	case DDSCode:
+		raw, err = client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+			return ddsClient.DescribeRegions(dds.CreateDescribeRegionsRequest())
+		})
+	case DRDSCode:
+		raw, err = client.WithDrdsClient(func(drdsClient *drds.Client) (interface{}, error) {
+			return drdsClient.DescribeRegions(drds.CreateDescribeRegionsRequest())
+		})
+	case GPDBCode:
+		raw, err = client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
+			return gpdbClient.DescribeRegions(gpdb.CreateDescribeRegionsRequest())
+		})
+	case ESSCode:
+		raw, err = client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+			return essClient.DescribeRegions(ess.CreateDescribeRegionsRequest())
+		})
+	case NASCode:
+		raw, err = client.WithNasClient(func(nasClient *nas.Client) (interface{}, error) {
+			return nasClient.DescribeRegions(nas.CreateDescribeRegionsRequest())
+		})
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// The responses of the products are in different structures, and all of them contain the RegionId fields.
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var response interface{}
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool)
+	collectRegionIds(response, found)
+	var regionIds []string
+	for id := range found {
+		regionIds = append(regionIds, id)
+	}
+	sort.Strings(regionIds)
+	return regionIds, nil
+}
+
+func collectRegionIds(value interface{}, found map[string]bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if id, ok := item.(string); ok && key == "RegionId" && id != "" {
+				found[id] = true
+				continue
+			}
+			collectRegionIds(item, found)
+		}
+	case []interface{}:
+		for _, item := range v {
+			collectRegionIds(item, found)
+		}
+	}
+}
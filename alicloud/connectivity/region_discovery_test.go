@@ -0,0 +1,98 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDescribeRegionIdsFromCache(t *testing.T) {
+	path := writeRegionCache(t, "cn-hangzhou", "cn-new-region")
+	defer os.RemoveAll(filepath.Dir(path))
+
+	client := &AliyunClient{RegionId: "cn-hangzhou", regionIds: newRegionIdCache(path)}
+	if !client.IsRegionSupported(ECSCode, "cn-new-region") {
+		t.Fatalf("the region in the cache is expected to be supported")
+	}
+	if client.IsRegionSupported(ECSCode, "cn-beijing") {
+		t.Fatalf("the region not in the cache is expected to be unsupported")
+	}
+	if err := client.validateRegionId("cn-beijing"); err == nil {
+		t.Fatalf("an error is expected for the region not in the cache")
+	}
+
+	// The products without the DescribeRegions API use their static regions.
+	expected := []string{}
+	for _, region := range DdoscooSupportedRegions {
+		expected = append(expected, string(region))
+	}
+	if regionIds := client.DescribeRegionIds(DDOSCOOCode); !reflect.DeepEqual(regionIds, expected) {
+		t.Fatalf("expected regions %v, got %v", expected, regionIds)
+	}
+}
+
+func TestDescribeRegionIdsConcurrently(t *testing.T) {
+	client := &AliyunClient{RegionId: "cn-hangzhou", regionIds: newRegionIdCache("")}
+	var calls int32
+	release := make(chan struct{})
+	client.regionIds.discover = func(product ServiceCode) ([]string, error) {
+		atomic.AddInt32(&calls, 1)
+		if product == ECSCode {
+			<-release
+		}
+		return []string{"cn-hangzhou", string(product)}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if regionIds := client.DescribeRegionIds(ECSCode); !reflect.DeepEqual(regionIds, []string{"cn-hangzhou", string(ECSCode)}) {
+				t.Errorf("unexpected regions %v", regionIds)
+			}
+		}()
+	}
+
+	// The lookup of another product is not blocked by the in-flight lookup of ECS.
+	done := make(chan []string)
+	go func() { done <- client.DescribeRegionIds(RDSCode) }()
+	select {
+	case regionIds := <-done:
+		if !reflect.DeepEqual(regionIds, []string{"cn-hangzhou", string(RDSCode)}) {
+			t.Fatalf("unexpected regions %v", regionIds)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("the lookup of %s is blocked by the lookup of %s", RDSCode, ECSCode)
+	}
+
+	close(release)
+	wg.Wait()
+	if calls != 2 {
+		t.Fatalf("expected the regions of each product to be discovered once, got %d calls", calls)
+	}
+}
+
+func TestCollectRegionIds(t *testing.T) {
+	var response interface{}
+	json.Unmarshal([]byte(`{"RequestId": "id", "Regions": {"RDSRegion": [
+		{"RegionId": "cn-hangzhou", "ZoneId": "cn-hangzhou-a"},
+		{"RegionId": "cn-hangzhou", "ZoneId": "cn-hangzhou-b"},
+		{"RegionId": "cn-beijing", "ZoneId": "cn-beijing-a"}]}}`), &response)
+	found := make(map[string]bool)
+	collectRegionIds(response, found)
+	var regionIds []string
+	for id := range found {
+		regionIds = append(regionIds, id)
+	}
+	sort.Strings(regionIds)
+	if !reflect.DeepEqual(regionIds, []string{"cn-beijing", "cn-hangzhou"}) {
+		t.Fatalf("unexpected regions %v", regionIds)
+	}
+}
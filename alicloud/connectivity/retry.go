@@ -0,0 +1,100 @@
+package connectivity
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// The max delay between two retries of one error category, whatever its base delay and attempts.
+const DefaultMaxRetryDelay = 60 * time.Second
+
+// The number of retries of throttled and transient errors which can be spent by the whole provider before retrying
+// them is stopped. Every successful Invoker call gives one retry back to the budget. Waiting for a dependency, like a
+// resource in a conflicting status, is not charged.
+const DefaultRetryBudget = 1000
+
+// RetryEngine computes exponential backoff with jitter and enforces the provider-wide retry limits,
+// which come from the provider arguments `max_retries` and `max_retry_timeout`.
+type RetryEngine struct {
+	// MaxRetries caps the retry count of every error category. Zero keeps the count of each category.
+	MaxRetries int
+	// MaxRetryTimeout caps the time spent on retrying one call. Zero means no limit.
+	MaxRetryTimeout time.Duration
+
+	budget   int
+	capacity int
+	random   *rand.Rand
+	mutex    sync.Mutex
+}
+
+func NewRetryEngine(maxRetries int, maxRetryTimeout time.Duration, budget int) *RetryEngine {
+	return &RetryEngine{
+		MaxRetries:      maxRetries,
+		MaxRetryTimeout: maxRetryTimeout,
+		budget:          budget,
+		capacity:        budget,
+		random:          rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// RetryEngine returns the retry engine shared by the clients of the provider, including the clients of the other
+// regions. A client which is not built by Config.Client, like in the unit tests, gets a new engine with the default
+// budget.
+func (client *AliyunClient) RetryEngine() *RetryEngine {
+	if client == nil || client.retryEngine == nil {
+		return NewRetryEngine(0, 0, DefaultRetryBudget)
+	}
+	return client.retryEngine
+}
+
+// Backoff returns the delay before the given retry attempt (starting from 1). The delay doubles on each attempt
+// from baseDelay up to DefaultMaxRetryDelay, and a random jitter picks a value between its half and itself.
+func (e *RetryEngine) Backoff(baseDelay time.Duration, attempt int) time.Duration {
+	if baseDelay <= 0 {
+		return 0
+	}
+	delay := baseDelay
+	for i := 1; i < attempt && delay < DefaultMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > DefaultMaxRetryDelay {
+		delay = DefaultMaxRetryDelay
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	half := int64(delay / 2)
+	return time.Duration(half + e.random.Int63n(half+1))
+}
+
+// Allow reports whether a call which started at start may be retried for the attempt-th time with the given delay,
+// and takes one retry from the budget if so.
+func (e *RetryEngine) Allow(retryCount, attempt int, start time.Time, delay time.Duration) bool {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if e.MaxRetries > 0 && retryCount > e.MaxRetries {
+		retryCount = e.MaxRetries
+	}
+	if attempt > retryCount {
+		return false
+	}
+	if e.MaxRetryTimeout > 0 && time.Since(start)+delay > e.MaxRetryTimeout {
+		return false
+	}
+	if e.budget <= 0 {
+		return false
+	}
+	e.budget--
+	return true
+}
+
+// Succeed gives one retry back to the budget.
+func (e *RetryEngine) Succeed() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.budget < e.capacity {
+		e.budget++
+	}
+}
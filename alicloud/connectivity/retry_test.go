@@ -0,0 +1,43 @@
+package connectivity
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryEngineBackoff(t *testing.T) {
+	engine := NewRetryEngine(0, 0, DefaultRetryBudget)
+	for attempt, expected := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 4: 8 * time.Second, 20: DefaultMaxRetryDelay} {
+		delay := engine.Backoff(time.Second, attempt)
+		if delay < expected/2 || delay > expected {
+			t.Fatalf("the delay of attempt %d is expected in [%s, %s], got %s", attempt, expected/2, expected, delay)
+		}
+	}
+	if delay := engine.Backoff(0, 3); delay != 0 {
+		t.Fatalf("the delay without base delay is expected 0, got %s", delay)
+	}
+}
+
+func TestRetryEngineAllow(t *testing.T) {
+	engine := NewRetryEngine(2, 0, DefaultRetryBudget)
+	if !engine.Allow(10, 2, time.Now(), 0) {
+		t.Fatalf("the 2nd retry is expected to be allowed")
+	}
+	if engine.Allow(10, 3, time.Now(), 0) {
+		t.Fatalf("the 3rd retry is expected to be stopped by max_retries")
+	}
+
+	engine = NewRetryEngine(0, time.Minute, DefaultRetryBudget)
+	if engine.Allow(10, 1, time.Now().Add(-50*time.Second), 20*time.Second) {
+		t.Fatalf("the retry is expected to be stopped by max_retry_timeout")
+	}
+
+	engine = NewRetryEngine(0, 0, 1)
+	if !engine.Allow(10, 1, time.Now(), 0) || engine.Allow(10, 1, time.Now(), 0) {
+		t.Fatalf("the retry budget is expected to allow only one retry")
+	}
+	engine.Succeed()
+	if !engine.Allow(10, 1, time.Now(), 0) {
+		t.Fatalf("a successful call is expected to refund the retry budget")
+	}
+}
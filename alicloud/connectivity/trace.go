@@ -0,0 +1,346 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ApiTrace is a structured record of one API call. A call sends several requests when the SDK retries it, and
+// Retries is the count of the resent ones.
+type ApiTrace struct {
+	Time      time.Time   `json:"time"`
+	Product   ServiceCode `json:"product"`
+	Region    string      `json:"region"`
+	Action    string      `json:"action,omitempty"`
+	RequestId string      `json:"request_id,omitempty"`
+	LatencyMs int64       `json:"latency_ms"`
+	Retries   int         `json:"retries"`
+	Status    int         `json:"status,omitempty"`
+	ErrorCode string      `json:"error_code,omitempty"`
+	Error     string      `json:"error,omitempty"`
+	// Request and Response are the redacted bodies, which are traced in the full body mode only.
+	Request  interface{} `json:"request,omitempty"`
+	Response interface{} `json:"response,omitempty"`
+
+	fullBody  bool
+	attempts  int
+	throttles int
+	mutex     sync.Mutex
+}
+
+// tracer writes the traces as JSON lines to a file, or to the log at the DEBUG level, which is shown by TF_LOG.
+type tracer struct {
+	file     *traceFile
+	fullBody bool
+}
+
+type traceFile struct {
+	mutex sync.Mutex
+	out   io.Writer
+}
+
+// The providers configured in the same process share the trace files.
+var traceFiles = make(map[string]*traceFile)
+var traceFilesMutex = sync.Mutex{}
+
+func newTracer(path string, fullBody bool) (*tracer, error) {
+	t := &tracer{fullBody: fullBody}
+	if path == "" {
+		return t, nil
+	}
+	traceFilesMutex.Lock()
+	defer traceFilesMutex.Unlock()
+	if file, ok := traceFiles[path]; ok {
+		t.file = file
+		return t, nil
+	}
+	out, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("Opening the API trace file %s got an error: %s", path, err)
+	}
+	t.file = &traceFile{out: out}
+	traceFiles[path] = t.file
+	return t, nil
+}
+
+// activeTrace is the call being traced. The SDK calls are serialized by goSdkMutex, so the requests sent by the
+// transports belong to it.
+var activeTrace struct {
+	sync.Mutex
+	trace *ApiTrace
+}
+
+func currentTrace() *ApiTrace {
+	activeTrace.Lock()
+	defer activeTrace.Unlock()
+	return activeTrace.trace
+}
+
+func (t *tracer) start(product ServiceCode, regionId string) *ApiTrace {
+	trace := &ApiTrace{Time: time.Now(), Product: product, Region: regionId, fullBody: t.fullBody}
+	activeTrace.Lock()
+	activeTrace.trace = trace
+	activeTrace.Unlock()
+	return trace
+}
+
+func (t *tracer) finish(trace *ApiTrace, raw interface{}, err error) {
+	activeTrace.Lock()
+	if activeTrace.trace == trace {
+		activeTrace.trace = nil
+	}
+	activeTrace.Unlock()
+
+	trace.mutex.Lock()
+	trace.LatencyMs = int64(time.Since(trace.Time) / time.Millisecond)
+	if trace.attempts > 1 {
+		trace.Retries = trace.attempts - 1
+	}
+	if trace.Action == "" {
+		trace.Action = responseAction(raw)
+	}
+	if err != nil {
+		trace.Error = err.Error()
+		if e, ok := err.(interface{ ErrorCode() string }); ok && e.ErrorCode() != "" {
+			trace.ErrorCode = e.ErrorCode()
+		}
+		// The requests of some SDKs are not seen by the transport, and their throttling is known by the error only.
+		if trace.throttles == 0 && isThrottlingCode(trace.ErrorCode) {
+			trace.throttles++
+		}
+		if e, ok := err.(interface{ RequestId() string }); ok && e.RequestId() != "" {
+			trace.RequestId = e.RequestId()
+		}
+	}
+	if trace.RequestId == "" {
+		trace.RequestId = responseRequestId(raw)
+	}
+	if trace.fullBody && trace.Response == nil && err == nil && raw != nil {
+		trace.Response = Redact(raw)
+	}
+	data, e := json.Marshal(trace)
+	trace.mutex.Unlock()
+	if e != nil {
+		log.Printf("[WARN] Encoding the API trace of %s got an error: %s", trace.Action, e)
+		return
+	}
+
+	if t.file == nil {
+		log.Printf("[DEBUG] [API TRACE] %s", data)
+		return
+	}
+	t.file.mutex.Lock()
+	defer t.file.mutex.Unlock()
+	if _, e := t.file.out.Write(append(data, '\n')); e != nil {
+		log.Printf("[WARN] Writing the API trace got an error: %s", e)
+	}
+}
+
+// observeRequest records a request sent for the call. The RPC APIs carry their action and region in the parameters.
+func (trace *ApiTrace) observeRequest(req *http.Request) {
+	params := req.URL.Query()
+	if req.Body != nil && strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if body, err := readBody(&req.Body); err == nil {
+			if form, err := url.ParseQuery(string(body)); err == nil {
+				for key, values := range form {
+					params[key] = append(params[key], values...)
+				}
+			}
+		}
+	}
+
+	trace.mutex.Lock()
+	defer trace.mutex.Unlock()
+	trace.attempts++
+	if action := params.Get("Action"); action != "" {
+		trace.Action = action
+	}
+	if regionId := params.Get("RegionId"); regionId != "" {
+		trace.Region = regionId
+	}
+	if trace.fullBody {
+		request := make(map[string]interface{})
+		for key, values := range params {
+			if len(values) == 1 {
+				request[key] = values[0]
+			} else {
+				request[key] = values
+			}
+		}
+		request["_method"] = req.Method
+		request["_path"] = req.URL.Path
+		trace.Request = Redact(request)
+	}
+}
+
+// observeResponse records the response of the last request sent for the call.
+func (trace *ApiTrace) observeResponse(resp *http.Response) {
+	var body []byte
+	if resp.Body != nil {
+		body, _ = readBody(&resp.Body)
+	}
+
+	trace.mutex.Lock()
+	defer trace.mutex.Unlock()
+	trace.Status = resp.StatusCode
+	trace.RequestId = resp.Header.Get("x-acs-request-id")
+	var response map[string]interface{}
+	if json.Unmarshal(body, &response) != nil {
+		response = nil
+	}
+	if id, ok := response["RequestId"].(string); ok && id != "" {
+		trace.RequestId = id
+	}
+	if code, ok := response["Code"].(string); ok && resp.StatusCode >= 400 {
+		trace.ErrorCode = code
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || isThrottlingCode(trace.ErrorCode) {
+		trace.throttles++
+	}
+	if trace.fullBody {
+		if response != nil {
+			trace.Response = Redact(response)
+		} else if len(body) > 0 {
+			// The other formats cannot be redacted, so only their sizes are traced.
+			trace.Response = fmt.Sprintf("<%s body of %d bytes>", resp.Header.Get("Content-Type"), len(body))
+		}
+	}
+}
+
+// readBody reads a request or response body and replaces it with a copy, so that it can still be read by the SDK.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	data, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	*body = ioutil.NopCloser(bytes.NewReader(data))
+	return data, err
+}
+
+// traceTransport records the requests and responses of the active trace.
+type traceTransport struct {
+	next http.RoundTripper
+}
+
+func (t *traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	trace := currentTrace()
+	if trace == nil {
+		return t.next.RoundTrip(req)
+	}
+	trace.observeRequest(req)
+	resp, err := t.next.RoundTrip(req)
+	if err == nil {
+		trace.observeResponse(resp)
+	}
+	return resp, err
+}
+
+// isThrottlingCode reports whether the error code means the request is rejected by the flow control, like
+// Throttling.User or ServiceUnavailable.Throttled.
+func isThrottlingCode(code string) bool {
+	return strings.Contains(strings.ToLower(code), "throttl")
+}
+
+// responseAction returns the action of a SDK response, like RunInstances of *ecs.RunInstancesResponse.
+func responseAction(raw interface{}) string {
+	value := reflect.ValueOf(raw)
+	if !value.IsValid() {
+		return ""
+	}
+	valueType := value.Type()
+	if valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+	name := valueType.Name()
+	if !strings.HasSuffix(name, "Response") {
+		return ""
+	}
+	return strings.TrimSuffix(name, "Response")
+}
+
+func responseRequestId(raw interface{}) string {
+	value := reflect.Indirect(reflect.ValueOf(raw))
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+	field := value.FieldByName("RequestId")
+	if field.Kind() != reflect.String {
+		return ""
+	}
+	return field.String()
+}
+
+// The values of these fields are always redacted, and the fields of the Sensitive attributes are added by the
+// provider. They are matched by their names in lower case without the separators.
+var redactedFields = struct {
+	sync.RWMutex
+	names map[string]bool
+}{names: map[string]bool{
+	"password": true, "accesskeysecret": true, "secretkey": true, "securitytoken": true, "signature": true,
+	"authorization": true, "privatekey": true, "xacssecuritytoken": true, "xosssecuritytoken": true,
+}}
+
+func redactedFieldName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+}
+
+// AddRedactedFields adds the names of the fields whose values are never traced, like MasterUserPassword or
+// master_user_password.
+func AddRedactedFields(names ...string) {
+	redactedFields.Lock()
+	defer redactedFields.Unlock()
+	for _, name := range names {
+		redactedFields.names[redactedFieldName(name)] = true
+	}
+}
+
+// IsRedactedField reports whether the values of the field are never traced. The indexed fields of the RPC
+// parameters, like DataDisk.1.Password, are matched by their last part.
+func IsRedactedField(name string) bool {
+	redactedFields.RLock()
+	defer redactedFields.RUnlock()
+	return redactedFields.names[redactedFieldName(name)]
+}
+
+// Redact returns a JSON compatible copy of the value, in which the values of the redacted fields are replaced.
+func Redact(value interface{}) interface{} {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("<%T which cannot be traced>", value)
+	}
+	var copied interface{}
+	if err := json.Unmarshal(data, &copied); err != nil {
+		return fmt.Sprintf("<%T which cannot be traced>", value)
+	}
+	return redact(copied)
+}
+
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if IsRedactedField(key) && item != nil && item != "" {
+				v[key] = "******"
+				continue
+			}
+			v[key] = redact(item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redact(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,87 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRedact(t *testing.T) {
+	AddRedactedFields("master_user_password")
+	redacted := Redact(map[string]interface{}{
+		"MasterUserPassword":  "secret",
+		"DataDisk.1.Password": "secret",
+		"Instances": []interface{}{
+			map[string]interface{}{"InstanceName": "web", "Password": "secret"},
+		},
+		"AccessKeySecret": "",
+	})
+	data, _ := json.Marshal(redacted)
+	expected := `{"AccessKeySecret":"","DataDisk.1.Password":"******","Instances":[{"InstanceName":"web","Password":"******"}],"MasterUserPassword":"******"}`
+	if string(data) != expected {
+		t.Fatalf("unexpected redacted value %s", data)
+	}
+}
+
+func TestTraceTransport(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.json")
+	tracer, err := newTracer(path, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attempts := 0
+	transport := &traceTransport{next: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		status, body := 200, `{"RequestId": "req-2", "InstanceId": "i-1"}`
+		if attempts == 1 {
+			status, body = 503, `{"RequestId": "req-1", "Code": "ServiceUnavailable"}`
+		}
+		return &http.Response{StatusCode: status, Header: http.Header{}, Body: ioutil.NopCloser(strings.NewReader(body))}, nil
+	})}
+
+	trace := tracer.start(ECSCode, "cn-beijing")
+	for i := 0; i < 2; i++ {
+		body := "Action=CreateInstance&RegionId=cn-hangzhou&Password=secret"
+		req, _ := http.NewRequest("POST", "https://ecs.aliyuncs.com/", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if data, _ := ioutil.ReadAll(resp.Body); !strings.Contains(string(data), "RequestId") {
+			t.Fatalf("the response body is expected to be readable after tracing, got %s", data)
+		}
+	}
+	tracer.finish(trace, nil, nil)
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var traced map[string]interface{}
+	if err := json.Unmarshal(data, &traced); err != nil {
+		t.Fatalf("the trace is expected to be a JSON line, got %s", data)
+	}
+	for key, value := range map[string]interface{}{
+		"product": "ECS", "region": "cn-hangzhou", "action": "CreateInstance", "request_id": "req-2", "retries": float64(1), "status": float64(200),
+	} {
+		if traced[key] != value {
+			t.Errorf("expected the traced %s to be %v, got %v", key, value, traced[key])
+		}
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Errorf("the password is expected to be redacted, got %s", data)
+	}
+	if currentTrace() != nil {
+		t.Errorf("the finished trace is expected not to be active")
+	}
+}
@@ -148,16 +148,16 @@ func doRequestCenBandwidthPackages(filters []cbn.DescribeCenBandwidthPackagesFil
 	var allCenBwps []cbn.CenBandwidthPackage
 
 	deadline := time.Now().Add(10 * time.Minute)
+	wait := incrementalWait(client, 10*time.Second, 5*time.Second)
 	for {
 		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
 			return cbnClient.DescribeCenBandwidthPackages(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, CenThrottlingUser) {
-				if time.Now().After(deadline) {
+			if IsThrottledError(err) {
+				if time.Now().After(deadline) || !wait() {
 					return nil, WrapErrorf(err, DataDefaultErrorMsg, "alicloud_cen_bandwidth_packages", request.GetActionName(), AlibabaCloudSdkGoERROR)
 				}
-				time.Sleep(10 * time.Second)
 				continue
 			}
 
@@ -117,16 +117,16 @@ func getCenInstances(filters []cbn.DescribeCensFilter, d *schema.ResourceData, m
 	var allCens []cbn.Cen
 
 	deadline := time.Now().Add(10 * time.Minute)
+	wait := incrementalWait(client, 10*time.Second, 5*time.Second)
 	for {
 		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
 			return cbnClient.DescribeCens(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, CenThrottlingUser) {
-				if time.Now().After(deadline) {
+			if IsThrottledError(err) {
+				if time.Now().After(deadline) || !wait() {
 					return nil, WrapErrorf(err, DataDefaultErrorMsg, "alicloud_cen_instances", request.GetActionName(), AlibabaCloudSdkGoERROR)
 				}
-				time.Sleep(10 * time.Second)
 				continue
 			}
 			return allCens, WrapErrorf(err, DataDefaultErrorMsg, "alicloud_cen_instances", request.GetActionName(), AlibabaCloudSdkGoERROR)
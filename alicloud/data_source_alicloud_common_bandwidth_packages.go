@@ -120,7 +120,7 @@ func dataSourceAlicloudCommonBandwidthPackagesRead(d *schema.ResourceData, meta
 			WrapError(err)
 		}
 	}
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
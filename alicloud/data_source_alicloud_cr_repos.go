@@ -134,7 +134,7 @@ func dataSourceAlicloudCRRepos() *schema.Resource {
 }
 func dataSourceAlicloudCRReposRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	getRepoListRequest := cr.CreateGetRepoListRequest()
 	getRepoListRequest.RegionId = string(client.Region)
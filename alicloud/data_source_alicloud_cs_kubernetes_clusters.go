@@ -289,7 +289,7 @@ func dataSourceAlicloudCSKubernetesClustersRead(d *schema.ResourceData, meta int
 
 	var allClusterTypes []cs.ClusterType
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	if err := invoker.Run(func() error {
 		raw, e := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 			return csClient.DescribeClusters("")
@@ -338,7 +338,7 @@ func dataSourceAlicloudCSKubernetesClustersRead(d *schema.ResourceData, meta int
 	for _, v := range filteredClusterTypes {
 		var kubernetesCluster cs.KubernetesCluster
 
-		invoker := NewInvoker()
+		invoker := NewInvoker(client)
 		if err := invoker.Run(func() error {
 			raw, e := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 				return csClient.DescribeKubernetesCluster(v.ClusterID)
@@ -504,8 +504,8 @@ func csKubernetesClusterDescriptionAttributes(d *schema.ResourceData, clusterTyp
 		var masterNodes []map[string]interface{}
 		var workerNodes []map[string]interface{}
 
-		invoker := NewInvoker()
 		client := meta.(*connectivity.AliyunClient)
+		invoker := NewInvoker(client)
 		pageNumber := 1
 		for {
 			var result []cs.KubernetesNodeType
@@ -550,7 +550,9 @@ func csKubernetesClusterDescriptionAttributes(d *schema.ResourceData, clusterTyp
 							return nil
 						}
 					}
-					time.Sleep(5 * time.Second)
+					if err := sleepContext(client, 5*time.Second); err != nil {
+						return resource.NonRetryableError(WrapError(err))
+					}
 					return resource.RetryableError(fmt.Errorf("[ERROR] There is no any nodes in kubernetes cluster %s.", d.Id()))
 				})
 				if err != nil {
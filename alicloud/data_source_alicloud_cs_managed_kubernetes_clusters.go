@@ -248,7 +248,7 @@ func dataSourceAlicloudCSManagerKubernetesClustersRead(d *schema.ResourceData, m
 
 	var allClusterTypes []cs.ClusterType
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	if err := invoker.Run(func() error {
 		raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 			return csClient.DescribeClusters("")
@@ -293,7 +293,7 @@ func dataSourceAlicloudCSManagerKubernetesClustersRead(d *schema.ResourceData, m
 	for _, v := range filteredClusterTypes {
 		var kubernetesCluster cs.KubernetesCluster
 
-		invoker := NewInvoker()
+		invoker := NewInvoker(client)
 		if err := invoker.Run(func() error {
 			raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 				return csClient.DescribeKubernetesCluster(v.ClusterID)
@@ -339,8 +339,8 @@ func csManagedKubernetesClusterDescriptionAttributes(d *schema.ResourceData, clu
 
 		var workerNodes []map[string]interface{}
 
-		invoker := NewInvoker()
 		client := meta.(*connectivity.AliyunClient)
+		invoker := NewInvoker(client)
 		pageNumber := 1
 		for {
 			var result []cs.KubernetesNodeType
@@ -385,7 +385,9 @@ func csManagedKubernetesClusterDescriptionAttributes(d *schema.ResourceData, clu
 							return nil
 						}
 					}
-					time.Sleep(5 * time.Second)
+					if err := sleepContext(client, 5*time.Second); err != nil {
+						return resource.NonRetryableError(WrapError(err))
+					}
 					return resource.RetryableError(fmt.Errorf("there is no any nodes in kubernetes cluster %s", d.Id()))
 				})
 				if err != nil {
@@ -130,13 +130,13 @@ func dataSourceAlicloudDBInstanceClassesRead(d *schema.ResourceData, meta interf
 	}
 	request.InstanceChargeType = instanceChargeType
 	var response = &rds.DescribeAvailableResourceResponse{}
+	wait := incrementalWait(client, 5*time.Second, 5*time.Second)
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DescribeAvailableResource(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, Throttling) {
-				time.Sleep(time.Duration(5) * time.Second)
+			if IsThrottledError(err) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
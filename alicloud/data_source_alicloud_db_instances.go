@@ -66,6 +66,11 @@ func dataSourceAlicloudDBInstances() *schema.Resource {
 					"Safe",
 				}),
 			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"tags": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -187,6 +192,7 @@ func dataSourceAlicloudDBInstancesRead(d *schema.ResourceData, meta interface{})
 	request.VpcId = d.Get("vpc_id").(string)
 	request.VSwitchId = d.Get("vswitch_id").(string)
 	request.ConnectionMode = d.Get("connection_mode").(string)
+	request.ResourceGroupId = d.Get("resource_group_id").(string)
 	request.Tags = d.Get("tags").(string)
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
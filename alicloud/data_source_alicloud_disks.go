@@ -50,6 +50,11 @@ func dataSourceAlicloudDisks() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"tags": tagsSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
@@ -143,6 +148,10 @@ func dataSourceAlicloudDisksRead(d *schema.ResourceData, meta interface{}) error
 
 	request := ecs.CreateDescribeDisksRequest()
 
+	if v, ok := d.GetOk("resource_group_id"); ok && v.(string) != "" {
+		request.ResourceGroupId = v.(string)
+	}
+
 	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
 		request.DiskIds = convertListToJsonString(v.([]interface{}))
 	}
@@ -31,6 +31,7 @@ func dataSourceAlicloudEips() *schema.Resource {
 				ForceNew: true,
 				MinItems: 1,
 			},
+			"tags": tagsSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -108,6 +109,16 @@ func dataSourceAlicloudEipsRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	var tagsIdsMap map[string]bool
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		tagService := TagService{client}
+		ids, err := tagService.DescribeResourceIdsByTags(VpcTagProduct, TagResourceEip, v.(map[string]interface{}))
+		if err != nil {
+			return WrapError(err)
+		}
+		tagsIdsMap = ids
+	}
+
 	var allEips []vpc.EipAddress
 
 	for {
@@ -134,6 +145,9 @@ func dataSourceAlicloudEipsRead(d *schema.ResourceData, meta interface{}) error
 					continue
 				}
 			}
+			if tagsIdsMap != nil && !tagsIdsMap[e.AllocationId] {
+				continue
+			}
 			allEips = append(allEips, e)
 		}
 
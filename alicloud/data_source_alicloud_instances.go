@@ -55,6 +55,11 @@ func dataSourceAlicloudInstances() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"tags": tagsSchema(),
 
 			"output_file": {
@@ -192,6 +197,9 @@ func dataSourceAlicloudInstancesRead(d *schema.ResourceData, meta interface{}) e
 
 	request := ecs.CreateDescribeInstancesRequest()
 	request.Status = d.Get("status").(string)
+	if v, ok := d.GetOk("resource_group_id"); ok && v.(string) != "" {
+		request.ResourceGroupId = v.(string)
+	}
 
 	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
 		request.InstanceIds = convertListToJsonString(v.([]interface{}))
@@ -36,6 +36,7 @@ func dataSourceAlicloudKmsKeys() *schema.Resource {
 				ValidateFunc: validateKmsKeyStatus,
 			},
 
+			"tags": tagsSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -136,6 +137,7 @@ func dataSourceAlicloudKmsKeysRead(d *schema.ResourceData, meta interface{}) err
 		r = regexp.MustCompile(descriptionRegex.(string))
 	}
 	status, statusOk := d.GetOk("status")
+	kmsService := KmsService{client}
 	for _, k := range keyIds {
 
 		request := kms.CreateDescribeKeyRequest()
@@ -154,6 +156,15 @@ func dataSourceAlicloudKmsKeysRead(d *schema.ResourceData, meta interface{}) err
 		if statusOk && status != "" && status != key.KeyMetadata.KeyState {
 			continue
 		}
+		if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+			tags, err := kmsService.DescribeKmsKeyTags(k)
+			if err != nil {
+				return WrapError(err)
+			}
+			if !tagsContain(tags, v.(map[string]interface{})) {
+				continue
+			}
+		}
 		mapping := map[string]interface{}{
 			"id":            key.KeyMetadata.KeyId,
 			"arn":           key.KeyMetadata.Arn,
@@ -74,13 +74,13 @@ func dataSourceAlicloudKVStoreInstanceEnginesRead(d *schema.ResourceData, meta i
 	instanceChargeType := d.Get("instance_charge_type").(string)
 	request.InstanceChargeType = instanceChargeType
 	var response = &r_kvstore.DescribeAvailableResourceResponse{}
+	wait := incrementalWait(client, 5*time.Second, 5*time.Second)
 	err := resource.Retry(time.Minute*5, func() *resource.RetryError {
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.DescribeAvailableResource(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, Throttling) {
-				time.Sleep(time.Duration(5) * time.Second)
+			if IsThrottledError(err) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
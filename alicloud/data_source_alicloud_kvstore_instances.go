@@ -179,6 +179,16 @@ func dataSourceAlicloudKVStoreInstancesRead(d *schema.ResourceData, meta interfa
 			idsMap[vv.(string)] = vv.(string)
 		}
 	}
+
+	var tagsIdsMap map[string]bool
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		tagService := TagService{client}
+		ids, err := tagService.DescribeResourceIdsByTags(KVStoreTagProduct, TagResourceKVStoreInstance, v.(map[string]interface{}))
+		if err != nil {
+			return WrapError(err)
+		}
+		tagsIdsMap = ids
+	}
 	for {
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.DescribeInstances(request)
@@ -203,6 +213,9 @@ func dataSourceAlicloudKVStoreInstancesRead(d *schema.ResourceData, meta interfa
 					continue
 				}
 			}
+			if tagsIdsMap != nil && !tagsIdsMap[item.InstanceId] {
+				continue
+			}
 			dbi = append(dbi, item)
 		}
 
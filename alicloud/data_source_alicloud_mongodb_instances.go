@@ -41,6 +41,7 @@ func dataSourceAlicloudMongoDBInstances() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags": tagsSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -209,6 +210,16 @@ func dataSourceAlicloudMongoDBInstancesRead(d *schema.ResourceData, meta interfa
 			idsMap[vv.(string)] = vv.(string)
 		}
 	}
+
+	var tagsIdsMap map[string]bool
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		tagService := TagService{client}
+		ids, err := tagService.DescribeResourceIdsByTags(DdsTagProduct, TagResourceMongoDBInstance, v.(map[string]interface{}))
+		if err != nil {
+			return WrapError(err)
+		}
+		tagsIdsMap = ids
+	}
 	for {
 		raw, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
 			return ddsClient.DescribeDBInstances(request)
@@ -240,6 +251,9 @@ func dataSourceAlicloudMongoDBInstancesRead(d *schema.ResourceData, meta interfa
 					continue
 				}
 			}
+			if tagsIdsMap != nil && !tagsIdsMap[item.DBInstanceId] {
+				continue
+			}
 			dbi = append(dbi, item)
 		}
 
@@ -91,7 +91,7 @@ func dataSourceAlicloudAccessGroupsRead(d *schema.ResourceData, meta interface{}
 			nameRegex = r
 		}
 	}
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
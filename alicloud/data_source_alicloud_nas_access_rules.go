@@ -86,7 +86,7 @@ func dataSourceAlicloudAccessRulesRead(d *schema.ResourceData, meta interface{})
 			idsMap[vv.(string)] = vv.(string)
 		}
 	}
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
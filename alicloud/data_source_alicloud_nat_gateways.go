@@ -20,6 +20,7 @@ func dataSourceAlicloudNatGateways() *schema.Resource {
 				ValidateFunc: validateNameRegex,
 				ForceNew:     true,
 			},
+			"tags": tagsSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -101,6 +102,16 @@ func dataSourceAlicloudNatGatewaysRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	var tagsIdsMap map[string]bool
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		tagService := TagService{client}
+		ids, err := tagService.DescribeResourceIdsByTags(VpcTagProduct, TagResourceNatGateway, v.(map[string]interface{}))
+		if err != nil {
+			return WrapError(err)
+		}
+		tagsIdsMap = ids
+	}
+
 	var allNatGateways []vpc.NatGateway
 	var nameRegex *regexp.Regexp
 	if v, ok := d.GetOk("name_regex"); ok {
@@ -110,7 +121,7 @@ func dataSourceAlicloudNatGatewaysRead(d *schema.ResourceData, meta interface{})
 			return WrapError(err)
 		}
 	}
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
@@ -139,6 +150,9 @@ func dataSourceAlicloudNatGatewaysRead(d *schema.ResourceData, meta interface{})
 					continue
 				}
 			}
+			if tagsIdsMap != nil && !tagsIdsMap[gateways.NatGatewayId] {
+				continue
+			}
 			allNatGateways = append(allNatGateways, gateways)
 		}
 
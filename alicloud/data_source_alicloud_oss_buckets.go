@@ -363,7 +363,7 @@ func bucketsDescriptionAttributes(d *schema.ResourceData, buckets []oss.BucketPr
 					ruleMappings = append(ruleMappings, ruleMapping)
 				}
 			}
-		} else if !IsExceptedErrors(err, []string{NoSuchCORSConfiguration}) {
+		} else if !IsProductError(err, connectivity.OSSCode, ErrorNotFound) {
 			log.Printf("[WARN] Unable to get CORS information for the bucket %s: %v", bucket.Name, err)
 		}
 		mapping["cors_rules"] = ruleMappings
@@ -384,7 +384,7 @@ func bucketsDescriptionAttributes(d *schema.ResourceData, buckets []oss.BucketPr
 				websiteMapping["error_document"] = v.Key
 			}
 			websiteMappings = append(websiteMappings, websiteMapping)
-		} else if !IsExceptedErrors(err, []string{NoSuchWebsiteConfiguration}) {
+		} else if !IsProductError(err, connectivity.OSSCode, ErrorNotFound) {
 			log.Printf("[WARN] Unable to get website information for the bucket %s: %v", bucket.Name, err)
 		}
 		mapping["website"] = websiteMappings
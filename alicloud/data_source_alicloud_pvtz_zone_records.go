@@ -88,7 +88,7 @@ func dataSourceAlicloudPvtzZoneRecordsRead(d *schema.ResourceData, meta interfac
 	var pvtzZoneRecords []pvtz.Record
 	var ids []string
 
-	invoker := PvtzInvoker()
+	invoker := PvtzInvoker(client)
 	// ids
 	idsMap := make(map[string]string)
 	if v, ok := d.GetOk("ids"); ok {
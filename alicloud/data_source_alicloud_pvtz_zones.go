@@ -96,7 +96,7 @@ func dataSourceAlicloudPvtzZonesRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	invoker := PvtzInvoker()
+	invoker := PvtzInvoker(client)
 
 	for {
 		var raw interface{}
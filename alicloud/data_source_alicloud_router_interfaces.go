@@ -185,7 +185,7 @@ func dataSourceAlicloudRouterInterfacesRead(d *schema.ResourceData, meta interfa
 	}
 
 	var allRouterInterfaces []vpc.RouterInterfaceType
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	for {
 		var response *vpc.DescribeRouterInterfacesResponse
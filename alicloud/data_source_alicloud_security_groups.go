@@ -34,6 +34,11 @@ func dataSourceAlicloudSecurityGroups() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"tags": tagsSchema(),
 			"ids": {
 				Type:     schema.TypeList,
@@ -89,6 +94,7 @@ func dataSourceAlicloudSecurityGroupsRead(d *schema.ResourceData, meta interface
 
 	request := ecs.CreateDescribeSecurityGroupsRequest()
 	request.VpcId = d.Get("vpc_id").(string)
+	request.ResourceGroupId = d.Get("resource_group_id").(string)
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
@@ -58,6 +58,11 @@ func dataSourceAlicloudSlbs() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
 			"tags": {
 				Type:         schema.TypeMap,
 				Optional:     true,
@@ -145,6 +150,10 @@ func dataSourceAlicloudSlbsRead(d *schema.ResourceData, meta interface{}) error
 
 	request := slb.CreateDescribeLoadBalancersRequest()
 
+	if v, ok := d.GetOk("resource_group_id"); ok && v.(string) != "" {
+		request.ResourceGroupId = v.(string)
+	}
+
 	if v, ok := d.GetOk("master_availability_zone"); ok && v.(string) != "" {
 		request.MasterZoneId = v.(string)
 	}
@@ -82,7 +82,7 @@ func dataSourceAlicloudSnatEntriesRead(d *schema.ResourceData, meta interface{})
 	}
 
 	var allSnatEntries []vpc.SnatTableEntry
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
@@ -40,6 +40,12 @@ func dataSourceAlicloudVpcs() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"tags": tagsSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -116,11 +122,12 @@ func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error
 
 	request := vpc.CreateDescribeVpcsRequest()
 	request.RegionId = string(client.Region)
+	request.ResourceGroupId = d.Get("resource_group_id").(string)
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 
 	var allVpcs []vpc.Vpc
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	for {
 		var raw interface{}
 		var err error
@@ -166,6 +173,16 @@ func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	var tagsIdsMap map[string]bool
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		tagService := TagService{client}
+		ids, err := tagService.DescribeResourceIdsByTags(VpcTagProduct, TagResourceVpc, v.(map[string]interface{}))
+		if err != nil {
+			return WrapError(err)
+		}
+		tagsIdsMap = ids
+	}
+
 	for _, v := range allVpcs {
 		if r != nil && !r.MatchString(v.VpcName) {
 			continue
@@ -176,6 +193,9 @@ func dataSourceAlicloudVpcsRead(d *schema.ResourceData, meta interface{}) error
 				continue
 			}
 		}
+		if tagsIdsMap != nil && !tagsIdsMap[v.VpcId] {
+			continue
+		}
 
 		if cidrBlock, ok := d.GetOk("cidr_block"); ok && v.CidrBlock != cidrBlock.(string) {
 			continue
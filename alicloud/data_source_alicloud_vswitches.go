@@ -41,6 +41,7 @@ func dataSourceAlicloudVSwitches() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"tags": tagsSchema(),
 			"output_file": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -136,7 +137,17 @@ func dataSourceAlicloudVSwitchesRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	invoker := NewInvoker()
+	var tagsIdsMap map[string]bool
+	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+		tagService := TagService{client}
+		ids, err := tagService.DescribeResourceIdsByTags(VpcTagProduct, TagResourceVSwitch, v.(map[string]interface{}))
+		if err != nil {
+			return WrapError(err)
+		}
+		tagsIdsMap = ids
+	}
+
+	invoker := NewInvoker(client)
 	for {
 		var raw interface{}
 		var err error
@@ -168,6 +179,9 @@ func dataSourceAlicloudVSwitchesRead(d *schema.ResourceData, meta interface{}) e
 					continue
 				}
 			}
+			if tagsIdsMap != nil && !tagsIdsMap[vsw.VSwitchId] {
+				continue
+			}
 
 			if nameRegex != nil {
 				if !nameRegex.MatchString(vsw.VSwitchName) {
@@ -190,6 +190,10 @@ func ecsInternetDiffSuppressFunc(k, old, new string, d *schema.ResourceData) boo
 	return true
 }
 
+func ecsStoppedModeDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("status").(string) != string(Stopped)
+}
+
 func ecsPostPaidDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	return common.InstanceChargeType(d.Get("instance_charge_type").(string)) == common.PostPaid
 }
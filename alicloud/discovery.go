@@ -0,0 +1,483 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// discoveryResource describes how the resources of a type are enumerated by the list logic of a data source.
+type discoveryResource struct {
+	resourceType string
+	dataSource   string
+	// listKey is the attribute of the data source holding the found resources, and each of them has an `id`.
+	listKey string
+	// nameKey is the attribute of a found resource used to name its resource block.
+	nameKey string
+}
+
+// discoveryResources are in the order of their dependencies, so that the generated configuration reads naturally.
+var discoveryResources = []discoveryResource{
+	{"alicloud_vpc", "alicloud_vpcs", "vpcs", "vpc_name"},
+	{"alicloud_vswitch", "alicloud_vswitches", "vswitches", "name"},
+	{"alicloud_security_group", "alicloud_security_groups", "groups", "name"},
+	{"alicloud_nat_gateway", "alicloud_nat_gateways", "gateways", "name"},
+	{"alicloud_eip", "alicloud_eips", "eips", "ip_address"},
+	{"alicloud_key_pair", "alicloud_key_pairs", "key_pairs", "key_name"},
+	{"alicloud_instance", "alicloud_instances", "instances", "name"},
+	{"alicloud_disk", "alicloud_disks", "disks", "name"},
+	{"alicloud_slb", "alicloud_slbs", "slbs", "name"},
+	{"alicloud_db_instance", "alicloud_db_instances", "instances", "name"},
+}
+
+const (
+	ImportFormatBlock   = "block"
+	ImportFormatCommand = "command"
+)
+
+// DiscoveryOptions are the filters of the discovery. The resources must match all of the set filters.
+type DiscoveryOptions struct {
+	ResourceTypes   []string
+	Tags            map[string]string
+	ResourceGroupId string
+}
+
+// DiscoveredResource is a resource found by the discovery, and its state is read by the resource itself.
+type DiscoveredResource struct {
+	Type  string
+	Name  string
+	Id    string
+	State *terraform.InstanceState
+}
+
+// Discover enumerates the resources in the region of the configured provider. The resources are listed by the data
+// sources, and then each of them is imported and read by its resource as `terraform import` does, so the found states
+// are the same as the ones written by terraform. The warnings about the skipped resources are written to logs.
+func Discover(provider *schema.Provider, options DiscoveryOptions, logs io.Writer) ([]DiscoveredResource, error) {
+	types := make(map[string]bool)
+	for _, t := range options.ResourceTypes {
+		if _, ok := findDiscoveryResource(t); !ok {
+			return nil, WrapError(fmt.Errorf("The resource type %s is not supported by the discovery. Expected one of %s.", t, strings.Join(DiscoveryResourceTypes(), ", ")))
+		}
+		types[t] = true
+	}
+
+	var resources []DiscoveredResource
+	names := make(map[string]bool)
+	for _, r := range discoveryResources {
+		if len(types) > 0 && !types[r.resourceType] {
+			continue
+		}
+		if reason := r.unsupportedFilter(provider, options); reason != "" {
+			fmt.Fprintf(logs, "[WARN] Skipping %s: %s\n", r.resourceType, reason)
+			continue
+		}
+		items, err := r.list(provider, options)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		for _, item := range items {
+			id, _ := item["id"].(string)
+			name, _ := item[r.nameKey].(string)
+			states, err := provider.ImportState(&terraform.InstanceInfo{Type: r.resourceType}, id)
+			if err != nil {
+				fmt.Fprintf(logs, "[WARN] Skipping %s %s: %s\n", r.resourceType, id, err)
+				continue
+			}
+			for _, state := range states {
+				state, err = provider.Refresh(&terraform.InstanceInfo{Type: r.resourceType}, state)
+				if err != nil {
+					fmt.Fprintf(logs, "[WARN] Skipping %s %s: %s\n", r.resourceType, id, err)
+					continue
+				}
+				if state == nil || state.ID == "" {
+					continue
+				}
+				resources = append(resources, DiscoveredResource{
+					Type:  r.resourceType,
+					Name:  discoveryResourceName(r.resourceType, name, state.ID, names),
+					Id:    state.ID,
+					State: state,
+				})
+			}
+		}
+	}
+	return resources, nil
+}
+
+// DiscoveryResourceTypes returns the resource types supported by the discovery.
+func DiscoveryResourceTypes() []string {
+	var types []string
+	for _, r := range discoveryResources {
+		types = append(types, r.resourceType)
+	}
+	return types
+}
+
+func findDiscoveryResource(resourceType string) (discoveryResource, bool) {
+	for _, r := range discoveryResources {
+		if r.resourceType == resourceType {
+			return r, true
+		}
+	}
+	return discoveryResource{}, false
+}
+
+// unsupportedFilter returns the reason why the resources cannot be filtered by the options, because listing them
+// without the filter would discover the resources which are not expected.
+func (r discoveryResource) unsupportedFilter(provider *schema.Provider, options DiscoveryOptions) string {
+	dataSource := provider.DataSourcesMap[r.dataSource]
+	if _, ok := dataSource.Schema["tags"]; !ok && len(options.Tags) > 0 {
+		return fmt.Sprintf("%s does not support filtering by tags", r.dataSource)
+	}
+	if _, ok := dataSource.Schema["resource_group_id"]; !ok && options.ResourceGroupId != "" {
+		return fmt.Sprintf("%s does not support filtering by resource group", r.dataSource)
+	}
+	return ""
+}
+
+// list runs the data source with the filters and returns the found resources.
+func (r discoveryResource) list(provider *schema.Provider, options DiscoveryOptions) ([]map[string]interface{}, error) {
+	dataSource := provider.DataSourcesMap[r.dataSource]
+	d := dataSource.Data(nil)
+	if len(options.Tags) > 0 {
+		var tags interface{} = options.Tags
+		if dataSource.Schema["tags"].Type == schema.TypeString {
+			data, err := json.Marshal(options.Tags)
+			if err != nil {
+				return nil, WrapError(err)
+			}
+			tags = string(data)
+		}
+		if err := d.Set("tags", tags); err != nil {
+			return nil, WrapError(err)
+		}
+	}
+	if options.ResourceGroupId != "" {
+		d.Set("resource_group_id", options.ResourceGroupId)
+	}
+	if err := dataSource.Read(d, provider.Meta()); err != nil {
+		return nil, WrapError(err)
+	}
+
+	var items []map[string]interface{}
+	for _, v := range d.Get(r.listKey).([]interface{}) {
+		if item, ok := v.(map[string]interface{}); ok && item["id"] != nil && item["id"].(string) != "" {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+var (
+	discoveryNameInvalidChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+	discoveryShellSafe        = regexp.MustCompile(`^[A-Za-z0-9_.:/@-]+$`)
+)
+
+// discoveryResourceName converts the name of a resource into a unique name of its resource block. The ID is used when
+// the resource has no name or none of the characters of its name can be used, like a name written in Chinese.
+func discoveryResourceName(resourceType, name, id string, used map[string]bool) string {
+	name = cleanDiscoveryResourceName(name)
+	if name == "" {
+		name = cleanDiscoveryResourceName(id)
+	}
+	if name == "" || (name[0] >= '0' && name[0] <= '9') {
+		name = "r_" + name
+	}
+	unique := name
+	for i := 2; used[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	used[resourceType+"."+unique] = true
+	return unique
+}
+
+func cleanDiscoveryResourceName(name string) string {
+	return strings.Trim(discoveryNameInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_-")
+}
+
+// WriteDiscoveredConfig writes the resource blocks of the discovered resources. Only the arguments of the resources are
+// written, and the sensitive, deprecated and default ones are left out.
+func WriteDiscoveredConfig(w io.Writer, provider *schema.Provider, resources []DiscoveredResource) error {
+	for _, r := range resources {
+		resource, ok := provider.ResourcesMap[r.Type]
+		if !ok {
+			return WrapError(fmt.Errorf("The resource type %s is not found.", r.Type))
+		}
+		d := resource.Data(r.State)
+		values := make(map[string]interface{})
+		for key := range resource.Schema {
+			values[key] = d.Get(key)
+		}
+		// The region of a resource is the region of the provider which discovers it.
+		delete(values, "region")
+
+		fmt.Fprintf(w, "resource %q %q {\n", r.Type, r.Name)
+		writeDiscoveredAttributes(w, resource.Schema, values, 1)
+		fmt.Fprintf(w, "}\n\n")
+	}
+	return nil
+}
+
+// WriteDiscoveredImports writes the import blocks or the `terraform import` commands of the discovered resources.
+func WriteDiscoveredImports(w io.Writer, resources []DiscoveredResource, format string) error {
+	for _, r := range resources {
+		switch format {
+		case ImportFormatBlock:
+			fmt.Fprintf(w, "import {\n  to = %s.%s\n  id = %s\n}\n\n", r.Type, r.Name, discoveryHCLString(r.Id))
+		case ImportFormatCommand:
+			fmt.Fprintf(w, "terraform import %s.%s %s\n", r.Type, r.Name, discoveryShellQuote(r.Id))
+		default:
+			return WrapError(fmt.Errorf("Invalid import format %s. Expected %s or %s.", format, ImportFormatBlock, ImportFormatCommand))
+		}
+	}
+	return nil
+}
+
+func writeDiscoveredAttributes(w io.Writer, schemaMap map[string]*schema.Schema, values map[string]interface{}, depth int) {
+	var keys []string
+	for key := range schemaMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	indent := strings.Repeat("  ", depth)
+	written := make(map[string]bool)
+	for _, key := range keys {
+		s := schemaMap[key]
+		value := values[key]
+		if !discoveryArgumentWritten(s, value, written) {
+			continue
+		}
+		written[key] = true
+
+		switch s.Type {
+		case schema.TypeList, schema.TypeSet:
+			list := discoveryList(value)
+			if elem, ok := s.Elem.(*schema.Resource); ok {
+				for _, v := range list {
+					item, _ := v.(map[string]interface{})
+					fmt.Fprintf(w, "%s%s {\n", indent, key)
+					writeDiscoveredAttributes(w, elem.Schema, item, depth+1)
+					fmt.Fprintf(w, "%s}\n", indent)
+				}
+				continue
+			}
+			var items []string
+			for _, v := range list {
+				items = append(items, discoveryHCLValue(v))
+			}
+			fmt.Fprintf(w, "%s%s = [%s]\n", indent, key, strings.Join(items, ", "))
+		case schema.TypeMap:
+			m, _ := value.(map[string]interface{})
+			var mapKeys []string
+			for k := range m {
+				mapKeys = append(mapKeys, k)
+			}
+			sort.Strings(mapKeys)
+			fmt.Fprintf(w, "%s%s = {\n", indent, key)
+			for _, k := range mapKeys {
+				fmt.Fprintf(w, "%s  %s = %s\n", indent, discoveryHCLString(k), discoveryHCLValue(m[k]))
+			}
+			fmt.Fprintf(w, "%s}\n", indent)
+		default:
+			fmt.Fprintf(w, "%s%s = %s\n", indent, key, discoveryHCLValue(value))
+		}
+	}
+}
+
+// discoveryArgumentWritten reports whether an argument is written. The computed attributes, the arguments which are
+// not set or equal to their defaults, and the arguments conflicting with a written one are left out.
+func discoveryArgumentWritten(s *schema.Schema, value interface{}, written map[string]bool) bool {
+	if (!s.Optional && !s.Required) || s.Sensitive || s.Deprecated != "" || s.Removed != "" {
+		return false
+	}
+	for _, key := range s.ConflictsWith {
+		if written[key] {
+			return false
+		}
+	}
+	if s.Required {
+		return true
+	}
+	if discoveryIsZero(value) {
+		return false
+	}
+	if s.Default != nil && fmt.Sprint(s.Default) == fmt.Sprint(value) {
+		return false
+	}
+	return true
+}
+
+func discoveryList(value interface{}) []interface{} {
+	switch v := value.(type) {
+	case *schema.Set:
+		return v.List()
+	case []interface{}:
+		return v
+	}
+	return nil
+}
+
+func discoveryIsZero(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case float64:
+		return v == 0
+	case bool:
+		return !v
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return len(discoveryList(value)) == 0
+}
+
+func discoveryHCLValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return discoveryHCLString(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
+
+// discoveryHCLString quotes a string, and escapes the sequences which would start an interpolation or a directive.
+func discoveryHCLString(s string) string {
+	s = strconv.Quote(s)
+	s = strings.Replace(s, "${", "$${", -1)
+	return strings.Replace(s, "%{", "%%{", -1)
+}
+
+func discoveryShellQuote(s string) string {
+	if discoveryShellSafe.MatchString(s) {
+		return s
+	}
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+// RunDiscoverCommand runs the `discover` sub-command of the provider binary, which writes the configuration and the
+// imports of the existing resources. The credentials and the region are read from the environment variables of the
+// provider, such as ALICLOUD_ACCESS_KEY, ALICLOUD_SECRET_KEY, ALICLOUD_REGION and ALICLOUD_PROFILE.
+func RunDiscoverCommand(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("discover", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	region := flags.String("region", "", "The region to discover. It defaults to the ALICLOUD_REGION environment variable.")
+	types := flags.String("types", "", fmt.Sprintf("A comma-separated list of the resource types to discover. All of %s are discovered by default.", strings.Join(DiscoveryResourceTypes(), ", ")))
+	tags := flags.String("tags", "", "A comma-separated list of key=value tags which the discovered resources must have.")
+	resourceGroupId := flags.String("resource-group-id", "", "The ID of the resource group which the discovered resources must belong to.")
+	out := flags.String("out", "", "The file which the configuration is written to. It defaults to the standard output.")
+	importFormat := flags.String("import-format", ImportFormatBlock, "The format of the imports, which is either block for the import blocks or command for the terraform import commands.")
+	importOut := flags.String("import-out", "", "The file which the imports are written to. They are appended to the configuration by default, and the commands are written to the standard output when the configuration is written to a file.")
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: terraform-provider-alicloud discover [options]\n\nWrites the configuration and the imports of the existing resources in a region.\n\nOptions:\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+	if *importFormat != ImportFormatBlock && *importFormat != ImportFormatCommand {
+		fmt.Fprintf(stderr, "Invalid -import-format %s. Expected %s or %s.\n", *importFormat, ImportFormatBlock, ImportFormatCommand)
+		return 2
+	}
+	if *importFormat == ImportFormatCommand && *importOut == "" {
+		if *out == "" || *out == "-" {
+			fmt.Fprintf(stderr, "The commands cannot be written with the configuration. Set -out or -import-out.\n")
+			return 2
+		}
+		*importOut = "-"
+	}
+
+	options := DiscoveryOptions{ResourceGroupId: *resourceGroupId}
+	if *types != "" {
+		options.ResourceTypes = strings.Split(*types, ",")
+	}
+	if *tags != "" {
+		options.Tags = make(map[string]string)
+		for _, tag := range strings.Split(*tags, ",") {
+			parts := strings.SplitN(tag, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				fmt.Fprintf(stderr, "Invalid tag %s. Expected the format key=value.\n", tag)
+				return 2
+			}
+			options.Tags[parts[0]] = parts[1]
+		}
+	}
+
+	provider := Provider().(*schema.Provider)
+	raw := make(map[string]interface{})
+	if *region != "" {
+		raw["region"] = *region
+	}
+	rawConfig, err := config.NewRawConfig(raw)
+	if err == nil {
+		err = provider.Configure(terraform.NewResourceConfig(rawConfig))
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Configuring the provider got an error: %s\n", err)
+		return 1
+	}
+
+	resources, err := Discover(provider, options, stderr)
+	if err != nil {
+		fmt.Fprintf(stderr, "Discovering the resources got an error: %s\n", err)
+		return 1
+	}
+
+	configWriter, closeConfig, err := discoveryOutput(*out, stdout)
+	if err != nil {
+		fmt.Fprintf(stderr, "%s\n", err)
+		return 1
+	}
+	defer closeConfig()
+	importWriter := configWriter
+	if *importOut != "" {
+		w, closeImports, err := discoveryOutput(*importOut, stdout)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s\n", err)
+			return 1
+		}
+		defer closeImports()
+		importWriter = w
+	}
+
+	if err := WriteDiscoveredConfig(configWriter, provider, resources); err != nil {
+		fmt.Fprintf(stderr, "Writing the configuration got an error: %s\n", err)
+		return 1
+	}
+	if err := WriteDiscoveredImports(importWriter, resources, *importFormat); err != nil {
+		fmt.Fprintf(stderr, "Writing the imports got an error: %s\n", err)
+		return 1
+	}
+	fmt.Fprintf(stderr, "Discovered %d resources.\n", len(resources))
+	return 0
+}
+
+func discoveryOutput(path string, stdout io.Writer) (io.Writer, func(), error) {
+	if path == "" || path == "-" {
+		return stdout, func() {}, nil
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, nil, WrapError(err)
+	}
+	return file, func() { file.Close() }, nil
+}
@@ -0,0 +1,150 @@
+package alicloud
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestDiscoveryResources(t *testing.T) {
+	provider := Provider().(*schema.Provider)
+	for _, r := range discoveryResources {
+		resource, ok := provider.ResourcesMap[r.resourceType]
+		if !ok || resource.Importer == nil {
+			t.Errorf("the resource %s is expected to exist and support import", r.resourceType)
+		}
+		dataSource, ok := provider.DataSourcesMap[r.dataSource]
+		if !ok {
+			t.Errorf("the data source %s is expected to exist", r.dataSource)
+			continue
+		}
+		list, ok := dataSource.Schema[r.listKey]
+		if !ok {
+			t.Errorf("the data source %s is expected to have %s", r.dataSource, r.listKey)
+			continue
+		}
+		elem, ok := list.Elem.(*schema.Resource)
+		if !ok {
+			t.Errorf("the %s of the data source %s is expected to be a list of resources", r.listKey, r.dataSource)
+			continue
+		}
+		for _, key := range []string{"id", r.nameKey} {
+			if _, ok := elem.Schema[key]; !ok {
+				t.Errorf("the %s of the data source %s is expected to have %s", r.listKey, r.dataSource, key)
+			}
+		}
+	}
+}
+
+func TestDiscoveryResourceName(t *testing.T) {
+	used := make(map[string]bool)
+	cases := []struct {
+		name     string
+		id       string
+		expected string
+	}{
+		{"My VPC", "vpc-1", "my_vpc"},
+		{"my-vpc", "vpc-2", "my-vpc"},
+		{"My VPC", "vpc-3", "my_vpc_2"},
+		{"", "vpc-4", "vpc-4"},
+		{"1st.vpc", "vpc-5", "r_1st_vpc"},
+		{"测试", "vpc-6", "vpc-6"},
+		{"测试 VPC", "vpc-7", "vpc"},
+		{"测试", "123", "r_123"},
+	}
+	for _, c := range cases {
+		if name := discoveryResourceName("alicloud_vpc", c.name, c.id, used); name != c.expected {
+			t.Errorf("expected the name of %q to be %q, got %q", c.name, c.expected, name)
+		}
+	}
+}
+
+func TestWriteDiscoveredConfig(t *testing.T) {
+	provider := &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"alicloud_test": {
+				Schema: map[string]*schema.Schema{
+					"name":        {Type: schema.TypeString, Required: true},
+					"description": {Type: schema.TypeString, Optional: true},
+					"size":        {Type: schema.TypeInt, Optional: true, Default: 20},
+					"password":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+					"status":      {Type: schema.TypeString, Computed: true},
+					"old_name":    {Type: schema.TypeString, Optional: true, Deprecated: "use name"},
+					"ids":         {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					"tags":        {Type: schema.TypeMap, Optional: true},
+					"rule": {
+						Type:     schema.TypeList,
+						Optional: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"port":    {Type: schema.TypeInt, Required: true},
+								"enabled": {Type: schema.TypeBool, Optional: true},
+							},
+						},
+					},
+					"region": {Type: schema.TypeString, Optional: true, Computed: true},
+				},
+			},
+		},
+	}
+	state := &terraform.InstanceState{
+		ID: "t-1",
+		Attributes: map[string]string{
+			"id":             "t-1",
+			"name":           "${test}",
+			"description":    "",
+			"size":           "20",
+			"password":       "secret",
+			"status":         "Available",
+			"old_name":       "old",
+			"ids.#":          "2",
+			"ids.0":          "a",
+			"ids.1":          "b",
+			"tags.%":         "1",
+			"tags.Env":       "prod",
+			"rule.#":         "1",
+			"rule.0.port":    "80",
+			"rule.0.enabled": "true",
+			"region":         "cn-hangzhou",
+		},
+	}
+	resources := []DiscoveredResource{{Type: "alicloud_test", Name: "example", Id: "t-1", State: state}}
+
+	var config bytes.Buffer
+	if err := WriteDiscoveredConfig(&config, provider, resources); err != nil {
+		t.Fatal(err)
+	}
+	expected := `resource "alicloud_test" "example" {
+  ids = ["a", "b"]
+  name = "$${test}"
+  rule {
+    enabled = true
+    port = 80
+  }
+  tags = {
+    "Env" = "prod"
+  }
+}
+
+`
+	if config.String() != expected {
+		t.Errorf("expected the configuration:\n%s\ngot:\n%s", expected, config.String())
+	}
+
+	var imports bytes.Buffer
+	if err := WriteDiscoveredImports(&imports, resources, ImportFormatBlock); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "import {\n  to = alicloud_test.example\n  id = \"t-1\"\n}\n\n"; imports.String() != expected {
+		t.Errorf("expected the import blocks %q, got %q", expected, imports.String())
+	}
+	imports.Reset()
+	if err := WriteDiscoveredImports(&imports, resources, ImportFormatCommand); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "terraform import alicloud_test.example t-1\n"; imports.String() != expected {
+		t.Errorf("expected the import commands %q, got %q", expected, imports.String())
+	}
+}
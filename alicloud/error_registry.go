@@ -0,0 +1,468 @@
+package alicloud
+
+import (
+	"net"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/denverdino/aliyungo/common"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// ErrorCategory is the kind of an API error, which decides how the resources, the Invoker and the waiters handle it.
+type ErrorCategory string
+
+const (
+	// ErrorUnknown is the category of the errors which are not in the registry.
+	ErrorUnknown = ErrorCategory("")
+	// ErrorNotFound means the resource does not exist or has been released.
+	ErrorNotFound = ErrorCategory("not_found")
+	// ErrorThrottled means the request is rejected by the flow control and should be retried later.
+	ErrorThrottled = ErrorCategory("throttled")
+	// ErrorConflict means the resource is busy or in a status which does not allow the operation yet.
+	ErrorConflict = ErrorCategory("conflict")
+	// ErrorRetryable means a transient server side failure.
+	ErrorRetryable = ErrorCategory("retryable")
+	// ErrorQuotaExceeded means a quota of the account is used up.
+	ErrorQuotaExceeded = ErrorCategory("quota_exceeded")
+	// ErrorFatal means the request can never succeed, like the invalid credentials.
+	ErrorFatal = ErrorCategory("fatal")
+)
+
+var errorCategories = []ErrorCategory{ErrorNotFound, ErrorThrottled, ErrorConflict, ErrorRetryable, ErrorQuotaExceeded, ErrorFatal}
+
+// commonErrors holds the error codes shared by all of the products.
+const commonErrors = connectivity.ServiceCode("COMMON")
+
+// errorRegistry classifies the error codes of the products. The codes are matched exactly, so adding a new error code
+// is a single entry here.
+var errorRegistry = map[connectivity.ServiceCode]map[ErrorCategory][]string{
+	commonErrors: {
+		ErrorNotFound:  {NotFound, InstanceNotFound, RamInstanceNotFound},
+		ErrorThrottled: {Throttling, "Throttling.User", "Throttling.Api"},
+		ErrorRetryable: {InternalError, ServiceUnavailable, AliyunGoClientFailure},
+		ErrorFatal: {"InvalidAccessKeyId", "InvalidAccessKeyId.NotFound", "InvalidAccessKeyId.Inactive", "SignatureDoesNotMatch",
+			"IncompleteSignature", "InvalidSecurityToken.Expired", "InvalidSecurityToken.MismatchWithAccessKey", "Forbidden.RAM",
+			"Forbidden.AccessKeyDisabled"},
+	},
+	connectivity.ECSCode: {
+		ErrorNotFound: {"InvalidInstanceId.NotFound", InvalidSecurityGroupIdNotFound, KeyPairNotFound, SnapshotNotFound,
+			"InvalidDiskId.NotFound", "InvalidEniId.NotFound", "InvalidImageId.NotFound", "InvalidSnapshotPolicyId.NotFound",
+			"InvalidLaunchTemplate.NotFound"},
+		ErrorConflict: {InstanceIncorrectStatus, "IncorrectInstanceStatus.Initializing", "IncorrectDiskStatus",
+			"IncorrectDiskStatus.Initializing", "OperationConflict", "InvalidOperation.Conflict", "InvalidOperation.InvalidEniState",
+			"InvalidOperation.InvalidEcsState", "SnapshotCreatedDisk", "SnapshotCreatedImage", SgDependencyViolation,
+			"DependencyViolation.RouteEntry"},
+		ErrorFatal: EcsOrderPaymentErrors,
+	},
+	connectivity.VPCCode: {
+		ErrorNotFound: {InvalidVpcIDNotFound, ForbiddenVpcNotFound, InvalidVswitchIDNotFound, AllocationIdNotFound,
+			InvalidNatGatewayIdNotFound, InvalidSnatTableIdNotFound, InvalidSnatEntryIdNotFound, InvalidForwardTableIdNotFound,
+			InvalidForwardEntryIdNotFound, InvalidRouteEntryNotFound, InvalidHaVipIdNotFound, VpnNotFound, CgwNotFound,
+			VpnConnNotFound, SslVpnServerNotFound, SslVpnClientCertNotFound, NetworkAclNotFound, InvalidInstanceIdNotFound,
+			InstanceNotExists},
+		ErrorConflict: {IncorrectVpcStatus, IncorrectStatus, TaskConflict, EipIncorrectStatus, HaVipIncorrectStatus,
+			IncorrectRouteEntryStatus, InvalidStatusRouteEntry, VswitchStatusError, TokenProcessing, DependencyViolation,
+			VpnConfiguring, InstanceIncorrectStatus, InvalidVipStatus, HasBeenUsedBySnatTable, HasBeenUsedByForwardEntry,
+			DependencyViolationBandwidthPackages, IncorretSnatEntryStatus, EIP_NOT_IN_GATEWAY, InvalidIpNotInNatgw,
+			RouterInterfaceIncorrectStatus, DependencyViolationRouterInterfaceReferedByRouteEntry,
+			IncorrectOppositeInterfaceInfoNotSet, OperationBlocking},
+		ErrorRetryable:     {UnknownError},
+		ErrorQuotaExceeded: {VpcQuotaExceeded, ResQuotaFull},
+	},
+	connectivity.SLBCode: {
+		ErrorNotFound: {LoadBalancerNotFound, InvalidRuleIdNotFound, SlbAclNotExists, SlbCACertificateIdNotFound,
+			SlbServerCertificateIdNotFound},
+		ErrorConflict: {"OperationBusy", "ServiceIsStopping", BackendServerConfiguring, "ServiceIsConfiguring", SlbTokenIsProcessing,
+			RspoolVipExist},
+		ErrorRetryable:     {"SystemBusy"},
+		ErrorQuotaExceeded: {SlbAclNumberOverLimit},
+	},
+	connectivity.RDSCode: {
+		ErrorNotFound: {InvalidDBInstanceIdNotFound, InvalidDBNameNotFound, InvalidDBInstanceNameNotFound,
+			InvalidAccountNameNotFound, InvalidCurrentConnectionStringNotFound, InvalidRwSplitNetTypeNotFound},
+		ErrorConflict: {"OperationDenied.DBStatus", "OperationDenied.DBInstanceStatus", DBOperationDeniedOutofUsage,
+			"OperationDenied.ReadDBInstanceStatus", "OperationDenied.MasterDBInstanceState", "ReadDBInstance.Mismatch"},
+	},
+	connectivity.GPDBCode: {
+		ErrorNotFound: {InvalidGpdbInstanceIdNotFound, InvalidGpdbNameNotFound, InvalidCurrentConnectionStringNotFound},
+		ErrorConflict: {"OperationDenied.DBStatus", InvalidGpdbInstanceStatus, InvalidGpdbConcurrentOperate, DBOperationDeniedOutofUsage},
+	},
+	connectivity.DDSCode: {
+		ErrorNotFound: {InvalidMongoDBInstanceIdNotFound, InvalidMongoDBNameNotFound},
+	},
+	connectivity.KVSTORECode: {
+		ErrorNotFound: {InvalidKVStoreInstanceIdNotFound, InvalidDBInstanceIdNotFound, InvalidDBInstanceNameNotFound},
+	},
+	connectivity.ESSCode: {
+		ErrorNotFound: {InvalidScalingGroupIdNotFound, InvalidScheduledTaskIdNotFound, InvalidScalingRuleIdNotFound,
+			InvalidLifecycleHookIdNotFound, InvalidEssScalingConfigurationIdNotFound, InvalidEssAlarmTaskNotFound},
+		ErrorConflict: {IncorrectScalingGroupStatus, ScalingActivityInProgress},
+	},
+	connectivity.NASCode: {
+		ErrorNotFound: {InvalidMountTargetNotFound, InvalidFileSystemIDNotFound, ForbiddenNasNotFound, InvalidLBidNotFound,
+			VolumeUnavailable, InvalidAccessGroupNotFound},
+	},
+	connectivity.CENCode: {
+		ErrorNotFound: {InstanceNotExist, ParameterCenInstanceIdNotExist, ParameterInstanceIdNotExist, ParameterIllegalCenInstanceId,
+			NotFoundRoute},
+		ErrorThrottled: {CenThrottlingUser},
+		ErrorConflict: {OperationBlocking, InvalidCenInstanceStatus, InvalidChildInstanceStatus, InvalidBwpInstanceStatus,
+			InvalidBwpBusinessStatus},
+		ErrorRetryable:     {UnknownError},
+		ErrorQuotaExceeded: {CenQuotaExceeded},
+	},
+	connectivity.PVTZCode: {
+		ErrorNotFound:  {ZoneNotExists, ZoneVpcNotExists},
+		ErrorThrottled: {PvtzThrottlingUser},
+		ErrorConflict:  {ZoneVpcExists},
+		ErrorRetryable: {PvtzSystemBusy},
+	},
+	connectivity.CDNCode: {
+		ErrorNotFound:  {InvalidDomainNotFound},
+		ErrorRetryable: {ServiceBusy},
+	},
+	connectivity.DNSCode: {
+		ErrorNotFound: {InvalidDomainNameNoExist, DomainRecordNotBelongToUser},
+		ErrorConflict: {RecordForbiddenDNSChange, FobiddenNotEmptyGroup},
+	},
+	connectivity.CLOUDAPICode: {
+		ErrorNotFound: {ApiGroupNotFound, ApiNotFound, NotFoundApp, NotFoundAuthorization, NotFoundStage},
+		ErrorConflict: {RepeatedCommit},
+	},
+	connectivity.DDOSCOOCode: {
+		ErrorNotFound: {DdoscooInstanceNotFound, InvalidDdoscooInstance},
+	},
+	connectivity.ACTIONTRAILCode: {
+		ErrorNotFound: {InvalidTrailNotFound},
+	},
+	connectivity.DRDSCode: {
+		ErrorNotFound: {InvalidDRDSInstanceIdNotFound},
+	},
+	connectivity.ELASTICSEARCHCode: {
+		ErrorNotFound: {ESInstanceNotFound},
+		ErrorConflict: {InstanceActivating},
+	},
+	connectivity.RAMCode: {
+		ErrorNotFound: {EntityNotExistRole, "EntityNotExist.User", "EntityNotExist.Group", "EntityNotExist.Policy",
+			InvalidRamRoleNotFound},
+		ErrorConflict: {DeleteConflictUserGroup, DeleteConflictUserAccessKey, DeleteConflictUserLoginProfile,
+			DeleteConflictUserMFADevice, DeleteConflictUserPolicy, DeleteConflictGroupUser, DeleteConflictGroupPolicy,
+			DeleteConflictPolicyUser, DeleteConflictPolicyGroup, DeleteConflictRolePolicy, DeleteConflictPolicyVersion},
+	},
+	connectivity.KMSCode: {
+		ErrorNotFound: {ForbiddenKeyNotFound},
+	},
+	connectivity.CRCode: {
+		ErrorNotFound: {ErrorNamespaceNotExist, ErrorRepoNotExist},
+	},
+	connectivity.CONTAINCode: {
+		ErrorNotFound: {ErrorClusterNotFound},
+	},
+	connectivity.ONSCode: {
+		ErrorNotFound: {OnsInstanceNotExist, AuthResourceOwnerError, InvalidDomainNameNoExist},
+		ErrorConflict: {OnsInstanceNotEmpty},
+	},
+	connectivity.CASCode: {
+		ErrorNotFound: {CertNotExist},
+	},
+	connectivity.OSSCode: {
+		ErrorNotFound:  {OssBucketNotFound, "NoSuchKey", NoSuchCORSConfiguration, NoSuchWebsiteConfiguration},
+		ErrorRetryable: {"RequestTimeout"},
+		ErrorFatal:     {AccessDenied, InsufficientBucketPolicyException},
+	},
+	connectivity.LOGCode: {
+		ErrorNotFound: {ProjectNotExist, LogStoreNotExist, IndexConfigNotExist, MachineGroupNotExist, GroupNotExist,
+			LogConfigNotExist},
+		ErrorThrottled: {"WriteQuotaExceed", "ReadQuotaExceed"},
+		ErrorRetryable: {InternalServerError, LogRequestTimeout},
+	},
+	connectivity.FCCode: {
+		ErrorNotFound:  {ServiceNotFound, FunctionNotFound, TriggerNotFound},
+		ErrorThrottled: {"ResourceThrottled"},
+		ErrorRetryable: {"ResourceExhausted"},
+	},
+	connectivity.MNSCode: {
+		ErrorNotFound: {QueueNotExist, TopicNotExist, SubscriptionNotExist},
+	},
+	connectivity.OTSCode: {
+		ErrorNotFound:  {OTSObjectNotExist},
+		ErrorThrottled: {OTSNotEnoughCapacityUnit},
+		ErrorConflict:  {OTSRowOperationConflict, OTSTableNotReady},
+		ErrorRetryable: {OTSServerBusy, OTSStorageServerBusy, OTSPartitionUnavailable, OTSInternalServerError, OTSTimeout,
+			OTSServerUnavailable},
+		ErrorQuotaExceeded: {OTSQuotaExhausted},
+	},
+	connectivity.DATAHUBCode: {
+		ErrorNotFound: {datahub.NoSuchProject, datahub.NoSuchTopic, datahub.NoSuchShard, datahub.NoSuchSubscription},
+	},
+}
+
+// errorMessageRegistry classifies the errors which have no code, like the MNS errors and the network failures, by the
+// fragments of their messages.
+var errorMessageRegistry = map[connectivity.ServiceCode]map[ErrorCategory][]string{
+	commonErrors: {
+		ErrorNotFound: {MessageInstanceNotFound},
+	},
+	connectivity.SLBCode: {
+		ErrorNotFound: {VServerGroupNotFoundMessage},
+	},
+	connectivity.CENCode: {
+		ErrorNotFound: {InstanceNotExistMsg},
+		ErrorConflict: {InvalidStateForOperationMsg},
+	},
+	connectivity.LOGCode: {
+		ErrorRetryable: {LogClientTimeout},
+	},
+	connectivity.MNSCode: {
+		ErrorNotFound: {QueueNotExist, TopicNotExist, SubscriptionNotExist},
+	},
+	connectivity.OTSCode: {
+		ErrorThrottled: {OTSQuotaFrequentMsg},
+		ErrorRetryable: {SuffixNoSuchHost},
+	},
+}
+
+// retryErrorCodeIndex is the category of the registered error codes of all of the products, except the not found ones.
+// ClassifyError and the helpers built on it, like IsConflictError and the Catchers of the Invoker, use it because most
+// call sites do not know which product returned the error, and retrying a busy resource of another product is harmless.
+// This is safe as long as a code means the same category in every product, which TestErrorRegistryHasNoConflicts
+// checks. The not found codes and the message fragments are not in it: treating an error of another product as gone
+// would drop a resource from the state, so ClassifyError only matches those of the product which returned the error.
+var retryErrorCodeIndex = buildRetryErrorCodeIndex()
+
+// productErrorCodeIndexes is the category of the registered error codes of each product, including the common ones.
+var productErrorCodeIndexes = buildProductErrorCodeIndexes()
+
+func buildErrorCodeIndex(registry map[connectivity.ServiceCode]map[ErrorCategory][]string) map[string]ErrorCategory {
+	index := make(map[string]ErrorCategory)
+	for _, categories := range registry {
+		for category, codes := range categories {
+			for _, code := range codes {
+				index[code] = category
+			}
+		}
+	}
+	return index
+}
+
+func buildRetryErrorCodeIndex() map[string]ErrorCategory {
+	index := buildErrorCodeIndex(errorRegistry)
+	for code, category := range index {
+		if category == ErrorNotFound {
+			delete(index, code)
+		}
+	}
+	return index
+}
+
+func buildProductErrorCodeIndexes() map[connectivity.ServiceCode]map[string]ErrorCategory {
+	indexes := make(map[connectivity.ServiceCode]map[string]ErrorCategory)
+	for product := range errorRegistry {
+		indexes[product] = buildErrorCodeIndex(productErrors(errorRegistry, product))
+	}
+	return indexes
+}
+
+// productErrors returns the part of the registry which holds the product and the common errors.
+func productErrors(registry map[connectivity.ServiceCode]map[ErrorCategory][]string, product connectivity.ServiceCode) map[connectivity.ServiceCode]map[ErrorCategory][]string {
+	return map[connectivity.ServiceCode]map[ErrorCategory][]string{
+		commonErrors: registry[commonErrors],
+		product:      registry[product],
+	}
+}
+
+// errorProduct returns the product of the errors which come from its own SDK, and the common errors for the others.
+func errorProduct(err error) connectivity.ServiceCode {
+	switch err.(type) {
+	case *sls.Error:
+		return connectivity.LOGCode
+	case oss.ServiceError, *oss.ServiceError:
+		return connectivity.OSSCode
+	case *fc.ServiceError:
+		return connectivity.FCCode
+	case datahub.DatahubError:
+		return connectivity.DATAHUBCode
+	case *tablestore.OtsError:
+		return connectivity.OTSCode
+	}
+	return commonErrors
+}
+
+// errorDetail returns the code, the message and the HTTP status of the error from the different SDKs.
+func errorDetail(err error) (code, message string, status int) {
+	switch e := err.(type) {
+	case *errors.ServerError:
+		return e.ErrorCode(), e.Message(), e.HttpStatus()
+	case *common.Error:
+		return e.Code, e.Message, e.StatusCode
+	case *ProviderError:
+		return e.ErrorCode(), e.Message(), 0
+	case *sls.Error:
+		return e.Code, e.Message, int(e.HTTPCode)
+	case oss.ServiceError:
+		return e.Code, e.Message, e.StatusCode
+	case *oss.ServiceError:
+		return e.Code, e.Message, e.StatusCode
+	case *fc.ServiceError:
+		return e.ErrorCode, e.ErrorMessage, e.HTTPStatus
+	case datahub.DatahubError:
+		return e.Code, e.Message, e.StatusCode
+	case *tablestore.OtsError:
+		return e.Code, e.Message, e.HttpStatusCode
+	}
+	return "", err.Error(), 0
+}
+
+// ClassifyError returns the category of the error when the product which returned it is unknown. The not found codes
+// and the message fragments are the common ones and those of the product of the SDK error type, and the other codes
+// are matched in retryErrorCodeIndex. The wrapped errors are unwrapped, and the codes which are not in the registry are
+// classified by their messages and HTTP status.
+func ClassifyError(err error) ErrorCategory {
+	return classifyError(err, "")
+}
+
+// ClassifyProductError returns the category of the error like ClassifyError, but only by the codes and messages
+// registered for the product and the common ones.
+func ClassifyProductError(product connectivity.ServiceCode, err error) ErrorCategory {
+	return classifyError(err, product)
+}
+
+func classifyError(err error, product connectivity.ServiceCode) ErrorCategory {
+	if e, ok := err.(*WrapErrorOld); ok {
+		err = e.originError
+	}
+	if err == nil {
+		return ErrorUnknown
+	}
+	if e, ok := err.(*ComplexError); ok {
+		if e.Err != nil && strings.HasPrefix(e.Err.Error(), ResourceNotFound) {
+			return ErrorNotFound
+		}
+		return classifyError(e.Cause, product)
+	}
+
+	if err == connectivity.ErrStopped {
+		return ErrorFatal
+	}
+
+	scope := product
+	if scope == "" {
+		scope = errorProduct(err)
+	}
+	index, ok := productErrorCodeIndexes[scope]
+	if !ok {
+		index = productErrorCodeIndexes[commonErrors]
+	}
+	code, message, status := errorDetail(err)
+	if category, ok := index[code]; ok {
+		return category
+	}
+	if product == "" {
+		if category, ok := retryErrorCodeIndex[code]; ok {
+			return category
+		}
+	}
+	message = strings.ToLower(message)
+	for _, category := range errorCategories {
+		for _, categories := range productErrors(errorMessageRegistry, scope) {
+			for _, fragment := range categories[category] {
+				if strings.Contains(message, strings.ToLower(fragment)) {
+					return category
+				}
+			}
+		}
+	}
+	switch {
+	case strings.HasPrefix(code, Throttling):
+		return ErrorThrottled
+	case strings.Contains(code, "QuotaExceeded"):
+		return ErrorQuotaExceeded
+	case status == 429:
+		return ErrorThrottled
+	case status == 503:
+		return ErrorRetryable
+	}
+	return ErrorUnknown
+}
+
+// IsErrorCategory reports whether the error is in the category.
+func IsErrorCategory(err error, category ErrorCategory) bool {
+	return category != ErrorUnknown && ClassifyError(err) == category
+}
+
+// IsProductError reports whether the error is in one of the categories by the codes and messages registered for the
+// product and the common ones. Unlike IsErrorCategory, the codes of the other products do not match.
+func IsProductError(err error, product connectivity.ServiceCode, categories ...ErrorCategory) bool {
+	category := ClassifyProductError(product, err)
+	if category == ErrorUnknown {
+		return false
+	}
+	for _, c := range categories {
+		if c == category {
+			return true
+		}
+	}
+	return false
+}
+
+// IsThrottledError reports whether the request is rejected by the flow control.
+func IsThrottledError(err error) bool {
+	return IsErrorCategory(err, ErrorThrottled)
+}
+
+// IsConflictError reports whether the resource is busy or not in a status which allows the operation.
+func IsConflictError(err error) bool {
+	return IsErrorCategory(err, ErrorConflict)
+}
+
+// IsRetryableError reports whether the error is a transient server side failure.
+func IsRetryableError(err error) bool {
+	return IsErrorCategory(err, ErrorRetryable)
+}
+
+// IsQuotaExceededError reports whether a quota of the account is used up.
+func IsQuotaExceededError(err error) bool {
+	return IsErrorCategory(err, ErrorQuotaExceeded)
+}
+
+// IsFatalError reports whether the request can never succeed, so it should not be retried.
+func IsFatalError(err error) bool {
+	return IsErrorCategory(err, ErrorFatal)
+}
+
+// IsAmbiguousError reports whether a request failed without knowing if it took effect, like a timeout or a dropped
+// connection. A create request which failed in this way may have created the resource.
+func IsAmbiguousError(err error) bool {
+	if e, ok := err.(*WrapErrorOld); ok {
+		err = e.originError
+	}
+	if e, ok := err.(*ComplexError); ok {
+		return IsAmbiguousError(e.Cause)
+	}
+	if err == nil || err == connectivity.ErrStopped {
+		return false
+	}
+	if e, ok := err.(*errors.ClientError); ok && e.ErrorCode() == errors.TimeoutErrorCode {
+		return true
+	}
+	if e, ok := err.(net.Error); ok && e.Timeout() {
+		return true
+	}
+	// The server responded to the request, and only its internal errors leave the result unknown.
+	if _, _, status := errorDetail(err); status > 0 {
+		return status >= 500
+	}
+	message := strings.ToLower(err.Error())
+	for _, fragment := range []string{"timeout", "timed out", "connection reset", "broken pipe", "unexpected eof"} {
+		if strings.Contains(message, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/denverdino/aliyungo/common"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestErrorRegistryHasNoConflicts(t *testing.T) {
+	categories := make(map[string]ErrorCategory)
+	for product, registry := range errorRegistry {
+		for category, codes := range registry {
+			for _, code := range codes {
+				if c, ok := categories[code]; ok && c != category {
+					t.Errorf("the error code %s of %s is registered as both %s and %s", code, product, c, category)
+				}
+				categories[code] = category
+			}
+		}
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	cases := []struct {
+		err      error
+		category ErrorCategory
+	}{
+		{nil, ErrorUnknown},
+		{errors.NewServerError(404, `{"Code": "InvalidInstanceId.NotFound"}`, ""), ErrorUnknown},
+		{errors.NewServerError(404, `{"Code": "Forbidden.InstanceNotFound"}`, ""), ErrorNotFound},
+		{errors.NewServerError(400, `{"Code": "Throttling.User"}`, ""), ErrorThrottled},
+		{errors.NewServerError(400, `{"Code": "Throttling.Resource"}`, ""), ErrorThrottled},
+		{errors.NewServerError(403, `{"Code": "IncorrectInstanceStatus"}`, ""), ErrorConflict},
+		{errors.NewServerError(400, `{"Code": "QuotaExceeded.EipCount"}`, ""), ErrorQuotaExceeded},
+		{errors.NewServerError(404, `{"Code": "InvalidAccessKeyId.NotFound"}`, ""), ErrorFatal},
+		{errors.NewServerError(403, `{"Code": "InvalidAccountStatus.NotEnoughBalance"}`, ""), ErrorFatal},
+		{errors.NewServerError(503, `{"Code": "Unexpected"}`, ""), ErrorRetryable},
+		{errors.NewServerError(400, `{"Code": "InvalidParameter"}`, ""), ErrorUnknown},
+		{&common.Error{ErrorResponse: common.ErrorResponse{Code: "Forbidden.InstanceNotFound"}}, ErrorNotFound},
+		{&sls.Error{Code: LogStoreNotExist}, ErrorNotFound},
+		{&sls.Error{Code: "WriteQuotaExceed"}, ErrorThrottled},
+		{oss.ServiceError{Code: OssBucketNotFound}, ErrorNotFound},
+		{&fc.ServiceError{ErrorCode: FunctionNotFound}, ErrorNotFound},
+		{&tablestore.OtsError{Code: OTSServerBusy}, ErrorRetryable},
+		{&tablestore.OtsError{Code: "OTSParameterInvalid", Message: OTSQuotaFrequentMsg}, ErrorThrottled},
+		{fmt.Errorf("aliyun_mns: code: QueueNotExist, message: The queue you provided is not exist."), ErrorUnknown},
+		{fmt.Errorf("dial tcp: lookup instance.cn-hangzhou.ots.aliyuncs.com: no such host"), ErrorUnknown},
+		{GetNotFoundErrorFromString("The instance is not found"), ErrorNotFound},
+		{WrapErrorf(Error("%s", GetNotFoundMessage("Vpc", "vpc-abc")), NotFoundMsg, ProviderERROR), ErrorNotFound},
+		{WrapError(errors.NewServerError(400, `{"Code": "OTSServerBusy"}`, "")), ErrorRetryable},
+	}
+	for i, c := range cases {
+		if category := ClassifyError(c.err); category != c.category {
+			t.Errorf("case %d: expected %v to be classified as %q, got %q", i, c.err, c.category, category)
+		}
+	}
+}
+
+func TestClassifyProductError(t *testing.T) {
+	vpcNotFound := errors.NewServerError(404, `{"Code": "InvalidVpcID.NotFound"}`, "")
+	cases := []struct {
+		product  connectivity.ServiceCode
+		err      error
+		category ErrorCategory
+	}{
+		{connectivity.VPCCode, vpcNotFound, ErrorNotFound},
+		{connectivity.ECSCode, vpcNotFound, ErrorUnknown},
+		{connectivity.ECSCode, errors.NewServerError(404, `{"Code": "InvalidInstanceId.NotFound"}`, ""), ErrorNotFound},
+		{connectivity.RDSCode, errors.NewServerError(403, `{"Code": "OperationDenied.DBInstanceStatus"}`, ""), ErrorConflict},
+		{connectivity.ECSCode, errors.NewServerError(403, `{"Code": "OperationDenied.DBInstanceStatus"}`, ""), ErrorUnknown},
+		{connectivity.ECSCode, errors.NewServerError(400, `{"Code": "Throttling.User"}`, ""), ErrorThrottled},
+		{connectivity.ServiceCode("UNKNOWN"), errors.NewServerError(500, `{"Code": "ServiceUnavailable"}`, ""), ErrorRetryable},
+		{connectivity.ServiceCode("UNKNOWN"), vpcNotFound, ErrorUnknown},
+		{connectivity.ECSCode, &common.Error{ErrorResponse: common.ErrorResponse{Code: AliyunGoClientFailure}}, ErrorRetryable},
+		{connectivity.ECSCode, WrapError(vpcNotFound), ErrorUnknown},
+		{connectivity.MNSCode, fmt.Errorf("aliyun_mns: code: QueueNotExist, message: The queue you provided is not exist."), ErrorNotFound},
+		{connectivity.OTSCode, fmt.Errorf("dial tcp: lookup instance.cn-hangzhou.ots.aliyuncs.com: no such host"), ErrorRetryable},
+		{connectivity.RDSCode, errors.NewServerError(403, `{"Code": "InvalidOrderTask.NotSupport"}`, ""), ErrorUnknown},
+	}
+	for i, c := range cases {
+		if category := ClassifyProductError(c.product, c.err); category != c.category {
+			t.Errorf("case %d: expected %v of %s to be classified as %q, got %q", i, c.err, c.product, c.category, category)
+		}
+	}
+
+	// The not found codes of a product only match when the product is known, but the retryable ones match anyway.
+	if NotFoundError(vpcNotFound) || IsErrorCategory(vpcNotFound, ErrorNotFound) {
+		t.Errorf("expected %v not to be a not found error of an unknown product", vpcNotFound)
+	}
+	if !IsConflictError(errors.NewServerError(403, `{"Code": "OperationDenied.DBInstanceStatus"}`, "")) {
+		t.Errorf("expected the conflict codes of all of the products to match")
+	}
+	if IsProductError(vpcNotFound, connectivity.ECSCode, ErrorNotFound, ErrorConflict) {
+		t.Errorf("expected %v not to be a not found error of %s", vpcNotFound, connectivity.ECSCode)
+	}
+}
+
+func TestInvokerRunCategoryCatcher(t *testing.T) {
+	invoker := Invoker{}
+	invoker.AddCatcher(Catcher{string(ErrorThrottled), 2, 0})
+
+	calls := 0
+	err := invoker.Run(func() error {
+		calls++
+		return &sls.Error{HTTPCode: 429, Code: "TooManyRequests"}
+	})
+	if err == nil || calls != 3 {
+		t.Fatalf("the throttled error is expected to be retried twice, got %d calls and error %v", calls, err)
+	}
+
+	calls = 0
+	err = invoker.Run(func() error {
+		calls++
+		return errors.NewServerError(400, `{"Code": "IncorrectInstanceStatus"}`, "")
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("the conflict error is expected not to be retried, got %d calls and error %v", calls, err)
+	}
+}
+
+func TestIsAmbiguousError(t *testing.T) {
+	cases := []struct {
+		err       error
+		ambiguous bool
+	}{
+		{nil, false},
+		{errors.NewClientError(errors.TimeoutErrorCode, "timeout", nil), true},
+		{WrapError(errors.NewClientError(errors.TimeoutErrorCode, "timeout", nil)), true},
+		{errors.NewServerError(500, `{"Code": "InternalError"}`, ""), true},
+		{errors.NewServerError(400, `{"Code": "OperationTimeout"}`, ""), false},
+		{errors.NewServerError(400, `{"Code": "InvalidParameter"}`, ""), false},
+		{fmt.Errorf("read tcp 10.0.0.1:80: connection reset by peer"), true},
+		{connectivity.ErrStopped, false},
+	}
+	for i, c := range cases {
+		if ambiguous := IsAmbiguousError(c.err); ambiguous != c.ambiguous {
+			t.Errorf("case %d: expected IsAmbiguousError(%v) to be %t", i, c.err, c.ambiguous)
+		}
+	}
+}
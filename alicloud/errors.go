@@ -27,6 +27,11 @@ const (
 	MessageInstanceNotFound = "instance is not found"
 	EcsThrottling           = "Throttling"
 	EcsInternalError        = "InternalError"
+	EcsDryRunOperation      = "DryRunOperation"
+	// order
+	NotEnoughBalance = "InvalidAccountStatus.NotEnoughBalance"
+	AccountArrearage = "Account.Arrearage"
+	InvalidPayMethod = "InvalidPayMethod"
 	// disk
 	InternalError       = "InternalError"
 	DependencyViolation = "DependencyViolation"
@@ -362,9 +367,11 @@ var DiskInvalidOperation = []string{"IncorrectDiskStatus", "IncorrectInstanceSta
 var NetworkInterfaceInvalidOperations = []string{"InvalidOperation.InvalidEniState", "InvalidOperation.InvalidEcsState", "OperationConflict", "ServiceUnavailable", "InternalError"}
 var OperationDeniedDBStatus = []string{"OperationDenied.DBStatus", "OperationDenied.DBInstanceStatus", DBInternalError, DBOperationDeniedOutofUsage}
 var DBReadInstanceNotReadyStatus = []string{"OperationDenied.ReadDBInstanceStatus", "OperationDenied.MasterDBInstanceState", "ReadDBInstance.Mismatch"}
-var NasNotFound = []string{InvalidMountTargetNotFound, InvalidFileSystemIDNotFound, ForbiddenNasNotFound, InvalidLBidNotFound, VolumeUnavailable}
 var SnapshotInvalidOperations = []string{"OperationConflict", "ServiceUnavailable", "InternalError", "SnapshotCreatedDisk", "SnapshotCreatedImage"}
 var SnapshotPolicyInvalidOperations = []string{"OperationConflict", "ServiceUnavailable", "InternalError", "SnapshotCreatedDisk", "SnapshotCreatedImage"}
+
+// The orders of the charge type and network spec changes are auto paid, and they fail when the account can not pay.
+var EcsOrderPaymentErrors = []string{NotEnoughBalance, AccountArrearage, InvalidPayMethod}
 var DiskNotSupportOnlineChangeErrors = []string{"InvalidDiskCategory.NotSupported", "InvalidRegion.NotSupport", "IncorrectInstanceStatus", "IncorrectDiskStatus", "InvalidOperation.InstanceTypeNotSupport"}
 
 // details at: https://help.aliyun.com/document_detail/27300.html
@@ -697,6 +704,7 @@ const DeleteTimeoutMsg = "Resource %s Still Exists. %s Timeout!!! %s"
 const WaitTimeoutMsg = "Resource %s %s Timeout In %d Seconds. Got: %s Expected: %s !!! %s"
 const DataDefaultErrorMsg = "Datasource %s %s Failed!!! %s"
 const IdMsg = "Resource id：%s "
+const OrderPaymentErrorMsg = "Resource %s %s Failed!!! The order can not be paid, please check the balance and the payment method of the account. %s"
 
 const DefaultDebugMsg = "\n*************** %s Response *************** \n%s\n%s******************************\n\n"
 const FailedToReachTargetStatus = "Failed to reach target status. Current status is %s."
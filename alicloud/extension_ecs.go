@@ -110,6 +110,13 @@ const (
 	DiskResizeTypeOnline  = DiskResizeType("online")
 )
 
+type StoppedMode string
+
+const (
+	KeepCharging = StoppedMode("KeepCharging")
+	StopCharging = StoppedMode("StopCharging")
+)
+
 type ImageOwnerAlias string
 
 const (
@@ -1,5 +1,7 @@
 package alicloud
 
+import "github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+
 type RecordType string
 
 const (
@@ -13,8 +15,8 @@ const (
 var PvtzThrottlingUserCatcher = Catcher{PvtzThrottlingUser, 30, 2}
 var PvtzSystemBusyCatcher = Catcher{PvtzSystemBusy, 30, 5}
 
-func PvtzInvoker() Invoker {
-	i := Invoker{}
+func PvtzInvoker(client *connectivity.AliyunClient) Invoker {
+	i := Invoker{client: client}
 	i.AddCatcher(PvtzThrottlingUserCatcher)
 	i.AddCatcher(ServiceBusyCatcher)
 	i.AddCatcher(PvtzSystemBusyCatcher)
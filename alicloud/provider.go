@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -9,7 +10,9 @@ import (
 	"os"
 	"regexp"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/mutexkv"
@@ -20,7 +23,7 @@ import (
 
 // Provider returns a schema.Provider for alicloud
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -31,12 +34,14 @@ func Provider() terraform.ResourceProvider {
 			"secret_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_SECRET_KEY", os.Getenv("ALICLOUD_SECRET_KEY")),
 				Description: descriptions["secret_key"],
 			},
 			"security_token": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_SECURITY_TOKEN", os.Getenv("SECURITY_TOKEN")),
 				Description: descriptions["security_token"],
 			},
@@ -73,13 +78,27 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_ACCOUNT_ID", os.Getenv("ALICLOUD_ACCOUNT_ID")),
 				Description: descriptions["account_id"],
 			},
-			"assume_role": assumeRoleSchema(),
+			"assume_role":           assumeRoleSchema(),
+			"assume_role_with_oidc": assumeRoleWithOidcSchema(),
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_CREDENTIAL_PROCESS", nil),
+				Description: descriptions["credential_process"],
+			},
 			"fc": {
 				Type:       schema.TypeString,
 				Optional:   true,
 				Deprecated: "Field 'fc' has been deprecated from provider version 1.28.0. New field 'fc' which in nested endpoints instead.",
 			},
-			"endpoints": endpointsSchema(),
+			"endpoints":       endpointsSchema(),
+			"api_rate_limits": apiRateLimitsSchema(),
+			"default_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: descriptions["default_tags"],
+			},
+			"ignore_tags": ignoreTagsSchema(),
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -96,6 +115,61 @@ func Provider() terraform.ResourceProvider {
 				Default:     false,
 				Description: descriptions["skip_region_validation"],
 			},
+			"skip_credentials_validation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["skip_credentials_validation"],
+			},
+			"allowed_account_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"forbidden_account_ids"},
+				Description:   descriptions["allowed_account_ids"],
+			},
+			"forbidden_account_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"allowed_account_ids"},
+				Description:   descriptions["forbidden_account_ids"],
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALICLOUD_MAX_RETRIES", 0),
+				ValidateFunc: validateIntegerInRange(0, INT_MAX),
+				Description:  descriptions["max_retries"],
+			},
+			"max_retry_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALICLOUD_MAX_RETRY_TIMEOUT", 0),
+				ValidateFunc: validateIntegerInRange(0, INT_MAX),
+				Description:  descriptions["max_retry_timeout"],
+			},
+			"batch_describe": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_BATCH_DESCRIBE", false),
+				Description: descriptions["batch_describe"],
+			},
+			"metrics_report_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_METRICS_REPORT_FILE", ""),
+				Description: descriptions["metrics_report_file"],
+			},
+			"metrics_report_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALICLOUD_METRICS_REPORT_FORMAT", string(connectivity.MetricsFormatJson)),
+				ValidateFunc: validateAllowedStringValue([]string{string(connectivity.MetricsFormatJson), string(connectivity.MetricsFormatPrometheus)}),
+				Description:  descriptions["metrics_report_format"],
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 
@@ -208,6 +282,10 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_network_interface":                  resourceAliyunNetworkInterface(),
 			"alicloud_network_interface_attachment":       resourceAliyunNetworkInterfaceAttachment(),
 			"alicloud_snapshot":                           resourceAliyunSnapshot(),
+			"alicloud_image":                              resourceAliyunImage(),
+			"alicloud_image_copy":                         resourceAliyunImageCopy(),
+			"alicloud_image_share_permission":             resourceAliyunImageSharePermission(),
+			"alicloud_image_export":                       resourceAliyunImageExport(),
 			"alicloud_snapshot_policy":                    resourceAliyunSnapshotPolicy(),
 			"alicloud_launch_template":                    resourceAliyunLaunchTemplate(),
 			"alicloud_security_group":                     resourceAliyunSecurityGroup(),
@@ -349,14 +427,57 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_network_acl_attachment":              resourceAliyunNetworkAclAttachment(),
 			"alicloud_network_acl_entries":                 resourceAliyunNetworkAclEntries(),
 		},
+	}
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigure(d, provider.StopContext(), strings.TrimSpace(os.Getenv("ALICLOUD_CASSETTE_PATH")))
+	}
+	for name, r := range provider.DataSourcesMap {
+		addResourceTypeClient(r, "data."+name)
+		addRegionOverride(r, true)
+	}
+	for name, r := range provider.ResourcesMap {
+		addResourceTypeClient(r, name)
+		addBatchedRefresh(r)
+		addRegionOverride(r, false)
+	}
+	connectivity.AddRedactedFields(sensitiveFields(provider)...)
+	return provider
+}
 
-		ConfigureFunc: providerConfigure,
+// sensitiveFields returns the names of the Sensitive attributes of the provider, the resources and the data sources,
+// whose values are redacted from the API traces. They are matched with the API fields, like master_user_password
+// with MasterUserPassword.
+func sensitiveFields(provider *schema.Provider) (names []string) {
+	var walk func(schemas map[string]*schema.Schema)
+	walk = func(schemas map[string]*schema.Schema) {
+		for name, s := range schemas {
+			if s.Sensitive {
+				names = append(names, name)
+			}
+			if r, ok := s.Elem.(*schema.Resource); ok {
+				walk(r.Schema)
+			}
+		}
 	}
+	walk(provider.Schema)
+	for _, r := range provider.ResourcesMap {
+		walk(r.Schema)
+	}
+	for _, r := range provider.DataSourcesMap {
+		walk(r.Schema)
+	}
+	return names
 }
 
 var providerConfig map[string]interface{}
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+// providerConfigRoleChain holds the ChainableRamRoleArn profiles chained on providerConfig in the order of assuming.
+var providerConfigRoleChain []map[string]interface{}
+
+// providerConfigure builds the client of the provider. The API calls, the retries and the waiters of the client stop
+// at once when stopContext is done. In the record mode of ALICLOUD_RECORD_MODE, the API exchanges are captured to or
+// served from the cassette at cassettePath.
+func providerConfigure(d *schema.ResourceData, stopContext context.Context, cassettePath string) (interface{}, error) {
 
 	accessKey := d.Get("access_key").(string)
 	if accessKey == "" {
@@ -400,7 +521,26 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Region:               connectivity.Region(strings.TrimSpace(region)),
 		RegionId:             strings.TrimSpace(region),
 		SkipRegionValidation: d.Get("skip_region_validation").(bool),
+		RecordMode:           connectivity.RecordMode(strings.TrimSpace(os.Getenv("ALICLOUD_RECORD_MODE"))),
+		CassettePath:         cassettePath,
+		TraceFile:            strings.TrimSpace(os.Getenv("ALICLOUD_TRACE_FILE")),
 	}
+	fullBody, _ := strconv.ParseBool(strings.TrimSpace(os.Getenv("ALICLOUD_TRACE_FULL_BODY")))
+	config.TraceFullBody = fullBody || debugOn()
+	config.BatchDescribe = d.Get("batch_describe").(bool)
+	config.MetricsReportFile = strings.TrimSpace(d.Get("metrics_report_file").(string))
+	config.MetricsReportFormat = connectivity.MetricsFormat(d.Get("metrics_report_format").(string))
+
+	config.RegionCacheFile = strings.TrimSpace(os.Getenv("ALICLOUD_REGION_CACHE_FILE"))
+	if config.RegionCacheFile == "" {
+		config.RegionCacheFile = connectivity.DefaultRegionCacheFile()
+	}
+	config.SkipCredentialsValidation = d.Get("skip_credentials_validation").(bool)
+	config.AllowedAccountIds = expandStringList(d.Get("allowed_account_ids").(*schema.Set).List())
+	config.ForbiddenAccountIds = expandStringList(d.Get("forbidden_account_ids").(*schema.Set).List())
+
+	config.MaxRetries = d.Get("max_retries").(int)
+	config.MaxRetryTimeout = time.Duration(d.Get("max_retry_timeout").(int)) * time.Second
 
 	token := d.Get("security_token").(string)
 	if token == "" {
@@ -411,67 +551,106 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	config.SecurityToken = strings.TrimSpace(token)
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
-		assumeRole := assumeRoleList[0].(map[string]interface{})
-		config.RamRoleArn = assumeRole["role_arn"].(string)
-		config.RamRoleSessionName = assumeRole["session_name"].(string)
-		config.RamRolePolicy = assumeRole["policy"].(string)
-		config.RamRoleSessionExpiration = assumeRole["session_expiration"].(int)
-		log.Printf("[INFO] assume_role configuration set: (RamRoleArn: %q, RamRoleSessionName: %q, RamRolePolicy: %q, RamRoleSessionExpiration: %d)",
-			config.RamRoleArn, config.RamRoleSessionName, config.RamRolePolicy, config.RamRoleSessionExpiration)
+	if v := d.Get("assume_role_with_oidc").([]interface{}); len(v) == 1 && v[0] != nil {
+		oidc := v[0].(map[string]interface{})
+		config.OidcProviderArn = strings.TrimSpace(oidc["oidc_provider_arn"].(string))
+		config.OidcTokenFile = strings.TrimSpace(oidc["oidc_token_file"].(string))
+		config.OidcRoleArn = strings.TrimSpace(oidc["role_arn"].(string))
+		config.OidcRoleSessionName = oidc["role_session_name"].(string)
+		config.OidcRoleSessionExpiration = oidc["session_expiration"].(int)
+	} else if os.Getenv("ALIBABA_CLOUD_OIDC_PROVIDER_ARN") != "" {
+		// The environment variables are injected by RRSA into the pods of the ACK clusters
+		config.OidcProviderArn = os.Getenv("ALIBABA_CLOUD_OIDC_PROVIDER_ARN")
+		config.OidcTokenFile = os.Getenv("ALIBABA_CLOUD_OIDC_TOKEN_FILE")
+		config.OidcRoleArn = os.Getenv("ALIBABA_CLOUD_ROLE_ARN")
+		config.OidcRoleSessionName = os.Getenv("ALIBABA_CLOUD_ROLE_SESSION_NAME")
 	} else {
-		roleArn, err := getConfigFromProfile(d, "ram_role_arn")
-		if err == nil && roleArn != nil {
-			config.RamRoleArn = roleArn.(string)
+		if v, err := getConfigFromProfile(d, "oidc_provider_arn"); err == nil && v != nil {
+			config.OidcProviderArn = v.(string)
 		}
-		sessionName, err := getConfigFromProfile(d, "ram_session_name")
-		if err == nil && sessionName != nil {
-			config.RamRoleSessionName = sessionName.(string)
+		if v, err := getConfigFromProfile(d, "oidc_token_file"); err == nil && v != nil {
+			config.OidcTokenFile = v.(string)
 		}
-		sessionExpiration, err := getConfigFromProfile(d, "expired_seconds")
-		if err == nil && sessionExpiration != nil {
-			config.RamRoleSessionExpiration = (int)(sessionExpiration.(float64))
+		if v, err := getConfigFromProfile(d, "oidc_role_arn"); err == nil && v != nil {
+			config.OidcRoleArn = v.(string)
 		}
 	}
+	if config.OidcRoleSessionName == "" {
+		config.OidcRoleSessionName = "terraform"
+	}
+	config.DisableEcsMetadataV1 = strings.ToLower(os.Getenv("ALIBABA_CLOUD_IMDSV1_DISABLED")) == "true"
+
+	credentialProcess := d.Get("credential_process").(string)
+	if credentialProcess == "" {
+		if v, err := getConfigFromProfile(d, "process_command"); err == nil && v != nil {
+			credentialProcess = v.(string)
+		}
+	}
+	config.CredentialProcess = strings.TrimSpace(credentialProcess)
+
+	if v, ok := d.GetOk("assume_role"); ok {
+		for _, raw := range v.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+			assumeRole := raw.(map[string]interface{})
+			role := connectivity.AssumeRole{
+				RoleArn:           assumeRole["role_arn"].(string),
+				SessionName:       assumeRole["session_name"].(string),
+				Policy:            assumeRole["policy"].(string),
+				ExternalId:        assumeRole["external_id"].(string),
+				SessionExpiration: assumeRole["session_expiration"].(int),
+			}
+			log.Printf("[INFO] assume_role configuration set: (RoleArn: %q, SessionName: %q, Policy: %q, SessionExpiration: %d)",
+				role.RoleArn, role.SessionName, role.Policy, role.SessionExpiration)
+			config.AssumeRoles = append(config.AssumeRoles, role)
+		}
+	} else {
+		assumeRoles, err := getAssumeRolesFromProfile(d)
+		if err != nil {
+			return nil, err
+		}
+		config.AssumeRoles = assumeRoles
+	}
+
+	if v, ok := d.GetOk("default_tags"); ok {
+		config.DefaultTags = make(map[string]string)
+		for key, value := range v.(map[string]interface{}) {
+			config.DefaultTags[key] = value.(string)
+		}
+	}
+	for _, v := range d.Get("ignore_tags").([]interface{}) {
+		if v == nil {
+			continue
+		}
+		ignoreTags := v.(map[string]interface{})
+		config.IgnoreTagKeys = expandStringList(ignoreTags["keys"].(*schema.Set).List())
+		config.IgnoreTagKeyPrefixes = expandStringList(ignoreTags["key_prefixes"].(*schema.Set).List())
+	}
+
+	for _, v := range d.Get("api_rate_limits").([]interface{}) {
+		limit := v.(map[string]interface{})
+		if limit["qps"].(float64) < 0 {
+			return nil, fmt.Errorf("The qps of api_rate_limits %s can not be negative.", limit["product"])
+		}
+		config.RateLimits = append(config.RateLimits, connectivity.RateLimit{
+			Product: connectivity.ServiceCode(strings.ToUpper(strings.TrimSpace(limit["product"].(string)))),
+			Action:  strings.TrimSpace(limit["action"].(string)),
+			Qps:     limit["qps"].(float64),
+			Burst:   limit["burst"].(int),
+		})
+	}
 
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
+	config.Endpoints = make(map[connectivity.ServiceCode]string)
 	for _, endpointsSetI := range endpointsSet.List() {
 		endpoints := endpointsSetI.(map[string]interface{})
-		config.EcsEndpoint = strings.TrimSpace(endpoints["ecs"].(string))
-		config.RdsEndpoint = strings.TrimSpace(endpoints["rds"].(string))
-		config.SlbEndpoint = strings.TrimSpace(endpoints["slb"].(string))
-		config.VpcEndpoint = strings.TrimSpace(endpoints["vpc"].(string))
-		config.CenEndpoint = strings.TrimSpace(endpoints["cen"].(string))
-		config.EssEndpoint = strings.TrimSpace(endpoints["ess"].(string))
-		config.OssEndpoint = strings.TrimSpace(endpoints["oss"].(string))
-		config.OssEndpoint = strings.TrimSpace(endpoints["ons"].(string))
-		config.DnsEndpoint = strings.TrimSpace(endpoints["dns"].(string))
-		config.RamEndpoint = strings.TrimSpace(endpoints["ram"].(string))
-		config.CsEndpoint = strings.TrimSpace(endpoints["cs"].(string))
-		config.CrEndpoint = strings.TrimSpace(endpoints["cr"].(string))
-		config.CdnEndpoint = strings.TrimSpace(endpoints["cdn"].(string))
-		config.KmsEndpoint = strings.TrimSpace(endpoints["kms"].(string))
-		config.OtsEndpoint = strings.TrimSpace(endpoints["ots"].(string))
-		config.CmsEndpoint = strings.TrimSpace(endpoints["cms"].(string))
-		config.PvtzEndpoint = strings.TrimSpace(endpoints["pvtz"].(string))
-		config.StsEndpoint = strings.TrimSpace(endpoints["sts"].(string))
-		config.LogEndpoint = strings.TrimSpace(endpoints["log"].(string))
-		config.DrdsEndpoint = strings.TrimSpace(endpoints["drds"].(string))
-		config.DdsEndpoint = strings.TrimSpace(endpoints["dds"].(string))
-		config.GpdbEnpoint = strings.TrimSpace(endpoints["gpdb"].(string))
-		config.KVStoreEndpoint = strings.TrimSpace(endpoints["kvstore"].(string))
-		config.FcEndpoint = strings.TrimSpace(endpoints["fc"].(string))
-		config.ApigatewayEndpoint = strings.TrimSpace(endpoints["apigateway"].(string))
-		config.DatahubEndpoint = strings.TrimSpace(endpoints["datahub"].(string))
-		config.MnsEndpoint = strings.TrimSpace(endpoints["mns"].(string))
-		config.LocationEndpoint = strings.TrimSpace(endpoints["location"].(string))
-		config.ElasticsearchEndpoint = strings.TrimSpace(endpoints["elasticsearch"].(string))
-		config.NasEndpoint = strings.TrimSpace(endpoints["nas"].(string))
-		config.ActionTrailEndpoint = strings.TrimSpace(endpoints["actiontrail"].(string))
-		config.BssOpenApiEndpoint = strings.TrimSpace(endpoints["bssopenapi"].(string))
-		config.DdoscooEndpoint = strings.TrimSpace(endpoints["ddoscoo"].(string))
+		for _, serviceCode := range connectivity.ServiceCodes {
+			if endpoint, ok := endpoints[strings.ToLower(string(serviceCode))].(string); ok && strings.TrimSpace(endpoint) != "" {
+				config.Endpoints[serviceCode] = strings.TrimSpace(endpoint)
+			}
+		}
 	}
 
 	if ots_instance_name, ok := d.GetOk("ots_instance_name"); ok && ots_instance_name.(string) != "" {
@@ -479,10 +658,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	if logEndpoint, ok := d.GetOk("log_endpoint"); ok && logEndpoint.(string) != "" {
-		config.LogEndpoint = strings.TrimSpace(logEndpoint.(string))
+		config.Endpoints[connectivity.LOGCode] = strings.TrimSpace(logEndpoint.(string))
 	}
 	if mnsEndpoint, ok := d.GetOk("mns_endpoint"); ok && mnsEndpoint.(string) != "" {
-		config.MnsEndpoint = strings.TrimSpace(mnsEndpoint.(string))
+		config.Endpoints[connectivity.MNSCode] = strings.TrimSpace(mnsEndpoint.(string))
 	}
 
 	if account, ok := d.GetOk("account_id"); ok && account.(string) != "" {
@@ -490,9 +669,11 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	if fcEndpoint, ok := d.GetOk("fc"); ok && fcEndpoint.(string) != "" {
-		config.FcEndpoint = strings.TrimSpace(fcEndpoint.(string))
+		config.Endpoints[connectivity.FCCode] = strings.TrimSpace(fcEndpoint.(string))
 	}
 
+	config.StopContext = stopContext
+
 	client, err := config.Client()
 	if err != nil {
 		return nil, err
@@ -530,9 +711,55 @@ func init() {
 
 		"assume_role_policy": "The permissions applied when assuming a role. You cannot use, this policy to grant further permissions that are in excess to those of the, role that is being assumed.",
 
+		"assume_role_external_id": "The external ID required by the trust policy of the role, which is typically used by the roles granted to third parties.",
+
 		"assume_role_session_expiration": "The time after which the established session for assuming role expires. Valid value range: [900-3600] seconds. Default to 0 (in this case Alicloud use own default value).",
 
-		"skip_region_validation": "Skip static validation of region ID. Used by users of alternative AlibabaCloud-like APIs or users w/ access to regions that are not public (yet).",
+		"assume_role_with_oidc_oidc_provider_arn": "The ARN of the OIDC identity provider.",
+
+		"assume_role_with_oidc_oidc_token_file": "The path of the file which contains the OIDC token. It is read again every time the credential is refreshed.",
+
+		"assume_role_with_oidc_role_arn": "The ARN of a RAM role to assume with the OIDC token.",
+
+		"assume_role_with_oidc_role_session_name": "The session name to use when assuming the role with the OIDC token. Default to `terraform`.",
+
+		"assume_role_with_oidc_session_expiration": "The time after which the established session for assuming role with the OIDC token expires. Valid value range: [900-43200] seconds. Default to 3600.",
+
+		"credential_process": "The external command which prints the credential in JSON, like the `process_command` of an `External` profile. It is run again before the credential expires.",
+
+		"skip_region_validation": "Skip validation of region ID. Used by users of alternative AlibabaCloud-like APIs or users w/ access to regions that are not public (yet).",
+
+		"region_override": "The region of the resource. Default to the region of the provider. The endpoints of the provider block are used only in the region of the provider.",
+
+		"skip_credentials_validation": "Skip validating the credential by the STS GetCallerIdentity API. Used in the environments which cannot reach STS.",
+
+		"allowed_account_ids": "The account IDs which are allowed to be managed. The provider fails when the credential belongs to any other account.",
+
+		"forbidden_account_ids": "The account IDs which are forbidden to be managed. The provider fails when the credential belongs to any of them.",
+
+		"max_retries": "The max retry count of each retryable API error, such as throttling and service unavailable. Default to 0, and in this case the built-in retry count of each error is used.",
+
+		"max_retry_timeout": "The max time in seconds spent on retrying the throttled and transient errors of one API call. Default to 0, which means no limit.",
+
+		"batch_describe": "Whether to coalesce the lookups of the instances, disks and VPCs refreshed at the same time into batched Describe calls. Default to false.",
+
+		"metrics_report_file": "The file to which the metrics of the API calls are written when the provider process exits. No metrics are collected if it is not set.",
+
+		"metrics_report_format": "The format of the metrics report, json or prometheus. Default to json.",
+
+		"default_tags": "The tags added to every taggable resource. The tags of a resource take precedence over them.",
+
+		"ignore_tags_keys": "The tag keys which are ignored by every resource, such as the ones added by other services.",
+
+		"ignore_tags_key_prefixes": "The tag key prefixes which are ignored by every resource, such as the ones added by other services.",
+
+		"api_rate_limits_product": "The product whose API requests are limited, such as ecs, vpc and slb.",
+
+		"api_rate_limits_action": "The API action whose requests are limited, such as DescribeInstances. If not set, the limit applies to all API requests of the product.",
+
+		"api_rate_limits_qps": "The max number of API requests sent per second. 0 means no limit.",
+
+		"api_rate_limits_burst": "The max number of API requests which can be sent at once. Default to the qps.",
 
 		"ecs_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom ECS endpoints.",
 
@@ -601,14 +828,15 @@ func init() {
 		"bssopenapi_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom BSSOPENAPI endpoints.",
 
 		"ddoscoo_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom DDOSCOO endpoints.",
+
+		"domain_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom Domain endpoints.",
 	}
 }
 
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
+		Type:     schema.TypeList,
 		Optional: true,
-		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -629,6 +857,11 @@ func assumeRoleSchema() *schema.Schema {
 					Optional:    true,
 					Description: descriptions["assume_role_policy"],
 				},
+				"external_id": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["assume_role_external_id"],
+				},
 				"session_expiration": {
 					Type:         schema.TypeInt,
 					Optional:     true,
@@ -641,6 +874,106 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
+func assumeRoleWithOidcSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"oidc_provider_arn": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["assume_role_with_oidc_oidc_provider_arn"],
+				},
+				"oidc_token_file": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["assume_role_with_oidc_oidc_token_file"],
+				},
+				"role_arn": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["assume_role_with_oidc_role_arn"],
+				},
+				"role_session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "terraform",
+					Description: descriptions["assume_role_with_oidc_role_session_name"],
+					ValidateFunc: stringMatch(regexp.MustCompile(`^[a-zA-Z0-9\. @\-_]{2,32}$`),
+						"Field can contain only A-Z, a-z, ., @, -, _ and valid length is [2-32]"),
+				},
+				"session_expiration": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      3600,
+					Description:  descriptions["assume_role_with_oidc_session_expiration"],
+					ValidateFunc: intBetween(900, 43200),
+				},
+			},
+		},
+	}
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
+			},
+		},
+	}
+}
+
+func apiRateLimitsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"product": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["api_rate_limits_product"],
+				},
+				"action": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["api_rate_limits_action"],
+				},
+				"qps": {
+					Type:        schema.TypeFloat,
+					Required:    true,
+					Description: descriptions["api_rate_limits_qps"],
+				},
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["api_rate_limits_burst"],
+					ValidateFunc: validateIntegerInRange(0, INT_MAX),
+				},
+			},
+		},
+	}
+}
+
 func endpointsSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -857,6 +1190,12 @@ func endpointsSchema() *schema.Schema {
 					Default:     "",
 					Description: descriptions["ddoscoo_endpoint"],
 				},
+				"domain": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["domain_endpoint"],
+				},
 			},
 		},
 		Set: endpointsToHash,
@@ -866,39 +1205,9 @@ func endpointsSchema() *schema.Schema {
 func endpointsToHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s-", m["ecs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["rds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["slb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["vpc"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cen"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ess"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["oss"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ons"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ram"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cs"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cdn"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kms"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ots"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cms"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["pvtz"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["sts"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["log"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["drds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["dds"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["gpdb"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["kvstore"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["fc"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["apigateway"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["datahub"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["mns"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["location"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["elasticsearch"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["nas"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["actiontrail"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["cas"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["bssopenapi"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", m["ddoscoo"].(string)))
+	for _, serviceCode := range connectivity.ServiceCodes {
+		buf.WriteString(fmt.Sprintf("%s-", m[strings.ToLower(string(serviceCode))].(string)))
+	}
 	return hashcode.String(buf.String())
 }
 
@@ -928,11 +1237,35 @@ func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{
 			if v, ok := d.GetOk("profile"); ok && v.(string) != "" {
 				current = v.(string)
 			}
+			profiles := make(map[string]map[string]interface{})
 			for _, v := range config["profiles"].([]interface{}) {
-				if current == v.(map[string]interface{})["name"] {
-					providerConfig = v.(map[string]interface{})
+				profile := v.(map[string]interface{})
+				if name, ok := profile["name"].(string); ok {
+					profiles[name] = profile
 				}
 			}
+			if profile, ok := profiles[current]; ok {
+				providerConfig = profile
+			}
+			// A ChainableRamRoleArn profile assumes its role with the credential of its source profile, which can be
+			// a ChainableRamRoleArn profile as well. The credential keys are read from the first source profile
+			// which is not chainable, and the roles are assumed in order from it.
+			providerConfigRoleChain = nil
+			visited := map[string]bool{current: true}
+			for providerConfig["mode"] == "ChainableRamRoleArn" {
+				providerConfigRoleChain = append([]map[string]interface{}{providerConfig}, providerConfigRoleChain...)
+				source, _ := providerConfig["source_profile"].(string)
+				if visited[source] {
+					providerConfig = nil
+					return nil, WrapError(fmt.Errorf("the source_profile %q of the profile %q refers to a profile in the chain", source, current))
+				}
+				visited[source] = true
+				if _, ok := profiles[source]; !ok {
+					providerConfig = nil
+					return nil, WrapError(fmt.Errorf("the source_profile %q of the profile %q is not found in %s", source, current, profilePath))
+				}
+				providerConfig = profiles[source]
+			}
 		}
 	}
 
@@ -959,6 +1292,19 @@ func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{
 		if mode != "RamRoleArn" {
 			return "", nil
 		}
+	case "oidc_provider_arn", "oidc_token_file":
+		if mode != "OIDC" {
+			return "", nil
+		}
+	case "oidc_role_arn":
+		if mode != "OIDC" {
+			return "", nil
+		}
+		return providerConfig["ram_role_arn"], nil
+	case "process_command":
+		if mode != "External" {
+			return "", nil
+		}
 	case "expired_seconds":
 		if mode != "RamRoleArn" {
 			return float64(0), nil
@@ -967,3 +1313,27 @@ func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{
 
 	return providerConfig[ProfileKey], nil
 }
+
+// getAssumeRolesFromProfile returns the RAM roles of the profile to assume in order, which are the role of a RamRoleArn
+// profile and the roles of the ChainableRamRoleArn profiles chained on it.
+func getAssumeRolesFromProfile(d *schema.ResourceData) ([]connectivity.AssumeRole, error) {
+	if _, err := getConfigFromProfile(d, "mode"); err != nil {
+		return nil, WrapError(err)
+	}
+	var assumeRoles []connectivity.AssumeRole
+	profiles := providerConfigRoleChain
+	if providerConfig["mode"] == "RamRoleArn" {
+		profiles = append([]map[string]interface{}{providerConfig}, profiles...)
+	}
+	for _, profile := range profiles {
+		role := connectivity.AssumeRole{}
+		role.RoleArn, _ = profile["ram_role_arn"].(string)
+		role.SessionName, _ = profile["ram_session_name"].(string)
+		role.ExternalId, _ = profile["external_id"].(string)
+		if v, ok := profile["expired_seconds"].(float64); ok {
+			role.SessionExpiration = int(v)
+		}
+		assumeRoles = append(assumeRoles, role)
+	}
+	return assumeRoles, nil
+}
@@ -2,8 +2,11 @@ package alicloud
 
 import (
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 
 	"strings"
@@ -35,12 +38,99 @@ func TestProvider_impl(t *testing.T) {
 	var _ terraform.ResourceProvider = Provider()
 }
 
-func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("ALICLOUD_ACCESS_KEY"); v == "" {
-		t.Fatal("ALICLOUD_ACCESS_KEY must be set for acceptance tests")
+func TestEndpointsSchema(t *testing.T) {
+	endpoints := endpointsSchema().Elem.(*schema.Resource).Schema
+	for _, serviceCode := range connectivity.ServiceCodes {
+		if _, ok := endpoints[strings.ToLower(string(serviceCode))]; !ok {
+			t.Fatalf("the endpoint of %s can not be overridden in the endpoints block", serviceCode)
+		}
+	}
+}
+
+func TestResourceTimeouts(t *testing.T) {
+	for name, r := range Provider().(*schema.Provider).ResourcesMap {
+		if r.Timeouts == nil {
+			continue
+		}
+		if r.Timeouts.Update != nil && r.Update == nil {
+			t.Errorf("%s has an update timeout but it cannot be updated", name)
+		}
+		if r.Timeouts.Create != nil && *r.Timeouts.Create <= 0 || r.Timeouts.Delete != nil && *r.Timeouts.Delete <= 0 {
+			t.Errorf("%s has a non-positive default timeout", name)
+		}
+	}
+	if r := Provider().(*schema.Provider).ResourcesMap["alicloud_kvstore_instance"]; r.Timeouts == nil || r.Timeouts.Create == nil {
+		t.Fatalf("alicloud_kvstore_instance is expected to have a create timeout")
+	}
+}
+
+func TestSensitiveFieldsRedacted(t *testing.T) {
+	Provider()
+	for _, field := range []string{"MasterUserPassword", "AccountPassword", "SecurityToken", "DataDisk.1.Password"} {
+		if !connectivity.IsRedactedField(field) {
+			t.Errorf("the field %s is expected to be redacted from the API traces", field)
+		}
+	}
+	if connectivity.IsRedactedField("InstanceName") {
+		t.Errorf("the field InstanceName is expected not to be redacted")
+	}
+}
+
+func TestGetAssumeRolesFromProfile(t *testing.T) {
+	profilePath := filepath.Join(os.TempDir(), fmt.Sprintf("tf-testacc-profile-%d.json", os.Getpid()))
+	profiles := `{"profiles": [
+		{"name": "security", "mode": "RamRoleArn", "access_key_id": "ak", "access_key_secret": "sk", "ram_role_arn": "acs:ram::1:role/security", "ram_session_name": "security"},
+		{"name": "transit", "mode": "ChainableRamRoleArn", "source_profile": "security", "ram_role_arn": "acs:ram::2:role/transit", "expired_seconds": 900},
+		{"name": "workload", "mode": "ChainableRamRoleArn", "source_profile": "transit", "ram_role_arn": "acs:ram::3:role/workload", "external_id": "abc"},
+		{"name": "loop", "mode": "ChainableRamRoleArn", "source_profile": "loop", "ram_role_arn": "acs:ram::4:role/loop"}
+	]}`
+	if err := ioutil.WriteFile(profilePath, []byte(profiles), 0600); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(profilePath)
+	defer func() {
+		providerConfig, providerConfigRoleChain = nil, nil
+	}()
+
+	providerConfig = nil
+	d := schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+		"shared_credentials_file": profilePath,
+		"profile":                 "workload",
+	})
+	roles, err := getAssumeRolesFromProfile(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []connectivity.AssumeRole{
+		{RoleArn: "acs:ram::1:role/security", SessionName: "security"},
+		{RoleArn: "acs:ram::2:role/transit", SessionExpiration: 900},
+		{RoleArn: "acs:ram::3:role/workload", ExternalId: "abc"},
+	}
+	if !reflect.DeepEqual(roles, expected) {
+		t.Fatalf("expected roles %v, got %v", expected, roles)
+	}
+	if v, err := getConfigFromProfile(d, "access_key_id"); err != nil || v != "ak" {
+		t.Fatalf("the access key is expected to be read from the source profile, got %v and error %v", v, err)
+	}
+
+	providerConfig = nil
+	d = schema.TestResourceDataRaw(t, Provider().(*schema.Provider).Schema, map[string]interface{}{
+		"shared_credentials_file": profilePath,
+		"profile":                 "loop",
+	})
+	if _, err := getAssumeRolesFromProfile(d); err == nil {
+		t.Fatalf("an error is expected when the source profiles form a loop")
 	}
-	if v := os.Getenv("ALICLOUD_SECRET_KEY"); v == "" {
-		t.Fatal("ALICLOUD_SECRET_KEY must be set for acceptance tests")
+}
+
+func testAccPreCheck(t *testing.T) {
+	if testAccPreCheckWithRecordMode(t) != connectivity.RecordModeReplay {
+		if v := os.Getenv("ALICLOUD_ACCESS_KEY"); v == "" {
+			t.Fatal("ALICLOUD_ACCESS_KEY must be set for acceptance tests")
+		}
+		if v := os.Getenv("ALICLOUD_SECRET_KEY"); v == "" {
+			t.Fatal("ALICLOUD_SECRET_KEY must be set for acceptance tests")
+		}
 	}
 	if v := os.Getenv("ALICLOUD_REGION"); v == "" {
 		log.Println("[INFO] Test: Using cn-beijing as test region")
@@ -48,6 +138,30 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// When ALICLOUD_RECORD_MODE is "record" or "replay", the API exchanges of each test case are captured to or served from
+// the cassette <ALICLOUD_CASSETTE_DIR>/<test name>.json, and the mode is returned. Replay mode does not need any
+// credentials.
+func testAccPreCheckWithRecordMode(t *testing.T) connectivity.RecordMode {
+	mode := connectivity.RecordMode(strings.TrimSpace(os.Getenv("ALICLOUD_RECORD_MODE")))
+	if mode == connectivity.RecordModeNone {
+		return mode
+	}
+	dir := strings.TrimSpace(os.Getenv("ALICLOUD_CASSETTE_DIR"))
+	if dir == "" {
+		dir = filepath.Join("testdata", "cassettes")
+	}
+	useCassette(testAccProvider, filepath.Join(dir, t.Name()+".json"))
+	return mode
+}
+
+// useCassette makes the provider capture its API exchanges to or serve them from the cassette at path. The test cases
+// which run in parallel need their own providers, each of which has its own cassette.
+func useCassette(provider *schema.Provider, path string) {
+	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		return providerConfigure(d, provider.StopContext(), path)
+	}
+}
+
 // currently not all account site type support create PostPaid resources, PayByBandwidth and other limits.
 // The setting of account site type can skip some unsupported cases automatically.
 
@@ -66,11 +180,13 @@ func testAccPreCheckWithAccountSiteType(t *testing.T, account AccountSite) {
 // If supported is true, the regions should a list of supporting the service regions.
 // If supported is false, the regions should a list of unsupporting the service regions.
 func testAccPreCheckWithRegions(t *testing.T, supported bool, regions []connectivity.Region) {
-	if v := os.Getenv("ALICLOUD_ACCESS_KEY"); v == "" {
-		t.Fatal("ALICLOUD_ACCESS_KEY must be set for acceptance tests")
-	}
-	if v := os.Getenv("ALICLOUD_SECRET_KEY"); v == "" {
-		t.Fatal("ALICLOUD_SECRET_KEY must be set for acceptance tests")
+	if testAccPreCheckWithRecordMode(t) != connectivity.RecordModeReplay {
+		if v := os.Getenv("ALICLOUD_ACCESS_KEY"); v == "" {
+			t.Fatal("ALICLOUD_ACCESS_KEY must be set for acceptance tests")
+		}
+		if v := os.Getenv("ALICLOUD_SECRET_KEY"); v == "" {
+			t.Fatal("ALICLOUD_SECRET_KEY must be set for acceptance tests")
+		}
 	}
 	if v := os.Getenv("ALICLOUD_REGION"); v == "" {
 		log.Println("[INFO] Test: Using cn-beijing as test region")
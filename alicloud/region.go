@@ -0,0 +1,114 @@
+package alicloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// addRegionOverride adds the optional `region` argument to a resource or data source, and makes all of its operations
+// use the client of that region, so that a single provider block can manage resources in several regions.
+// The provider region is used and saved when `region` is not set.
+func addRegionOverride(r *schema.Resource, isDataSource bool) {
+	if _, ok := r.Schema["region"]; ok {
+		return
+	}
+	r.Schema["region"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    !isDataSource,
+		Description: descriptions["region_override"],
+	}
+
+	r.Create = withRegionClient(r.Create)
+	r.Read = withRegionClient(r.Read)
+	r.Update = withRegionClient(r.Update)
+	r.Delete = withRegionClient(r.Delete)
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			client, err := regionClient(d, meta)
+			if err != nil {
+				return false, WrapError(err)
+			}
+			return exists(d, client)
+		}
+	}
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			client, err := regionClient(d, meta)
+			if err != nil {
+				return nil, WrapError(err)
+			}
+			return state(d, client)
+		}
+	}
+}
+
+func withRegionClient(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client, err := regionClient(d, meta)
+		if err != nil {
+			return WrapError(err)
+		}
+		if err := f(d, client); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			d.Set("region", client.RegionId)
+		}
+		return nil
+	}
+}
+
+// regionClient returns the client of the region of the resource, or the provider client if it is not set.
+func regionClient(d *schema.ResourceData, meta interface{}) (*connectivity.AliyunClient, error) {
+	client := meta.(*connectivity.AliyunClient)
+	if v, ok := d.GetOk("region"); ok {
+		return client.ForRegion(v.(string))
+	}
+	return client, nil
+}
+
+// addResourceTypeClient makes all of the operations of a resource or data source use the client of its type, so that
+// their API calls are recorded in the metrics of the type. It is added before addRegionOverride, which passes the
+// client of the resource region to it.
+func addResourceTypeClient(r *schema.Resource, resourceType string) {
+	typed := func(meta interface{}) interface{} {
+		if client, ok := meta.(*connectivity.AliyunClient); ok && client != nil {
+			return client.ForResourceType(resourceType)
+		}
+		return meta
+	}
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, typed(meta))
+		}
+	}
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			return exists(d, typed(meta))
+		}
+	}
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			return state(d, typed(meta))
+		}
+	}
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+			return customizeDiff(d, typed(meta))
+		}
+	}
+}
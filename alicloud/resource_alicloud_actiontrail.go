@@ -18,6 +18,12 @@ func resourceAlicloudActiontrail() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -110,7 +116,7 @@ func resourceAlicloudActiontrailCreate(d *schema.ResourceData, meta interface{})
 	if err := trailService.startActionTrail(d.Id()); err != nil {
 		return WrapError(err)
 	}
-	if err := trailService.WaitForActionTrail(d.Id(), Enable, DefaultTimeout); err != nil {
+	if err := trailService.WaitForActionTrail(d.Id(), Enable, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -201,7 +207,7 @@ func resourceAlicloudActiontrailDelete(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidVpcIDNotFound, ForbiddenVpcNotFound, InvalidTrailNotFound}) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) || IsProductError(err, connectivity.ACTIONTRAILCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultTimeoutMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -209,6 +215,6 @@ func resourceAlicloudActiontrailDelete(d *schema.ResourceData, meta interface{})
 
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(trailService.WaitForActionTrail(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(trailService.WaitForActionTrail(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 
@@ -21,6 +22,10 @@ func resourceAliyunApigatewayApi() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"group_id": {
 				Type:     schema.TypeString,
@@ -591,13 +596,13 @@ func resourceAliyunApigatewayApiDelete(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		if IsExceptedError(err, ApiNotFound) {
+		if IsProductError(err, connectivity.CLOUDAPICode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	return WrapError(cloudApiService.WaitForApiGatewayApi(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(cloudApiService.WaitForApiGatewayApi(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func buildAliyunApiArgs(d *schema.ResourceData, meta interface{}) (*cloudapi.CreateApiRequest, error) {
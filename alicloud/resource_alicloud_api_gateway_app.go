@@ -23,6 +23,10 @@ func resourceAliyunApigatewayApp() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -112,7 +116,9 @@ func resourceAliyunApigatewayAppUpdate(d *schema.ResourceData, meta interface{})
 		}
 		addDebug(request.GetActionName(), raw)
 	}
-	time.Sleep(3 * time.Second)
+	if err := sleepContext(client, 3*time.Second); err != nil {
+		return WrapError(err)
+	}
 	return resourceAliyunApigatewayAppRead(d, meta)
 }
 
@@ -127,11 +133,11 @@ func resourceAliyunApigatewayAppDelete(d *schema.ResourceData, meta interface{})
 		return cloudApiClient.DeleteApp(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, NotFoundApp) {
+		if IsProductError(err, connectivity.CLOUDAPICode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	return WrapError(cloudApiService.WaitForApiGatewayApp(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(cloudApiService.WaitForApiGatewayApp(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,6 +14,14 @@ func resourceAliyunApigatewayAppAttachment() *schema.Resource {
 		Create: resourceAliyunApigatewayAppAttachmentCreate,
 		Read:   resourceAliyunApigatewayAppAttachmentRead,
 		Delete: resourceAliyunApigatewayAppAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: importStateParseResourceId(4),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -68,7 +77,7 @@ func resourceAliyunApigatewayAppAttachmentCreate(d *schema.ResourceData, meta in
 
 	id := fmt.Sprintf("%s%s%s%s%s%s%s", groupId, COLON_SEPARATED, apiId, COLON_SEPARATED, appId, COLON_SEPARATED, stageName)
 
-	err = cloudApiService.WaitForApiGatewayAppAttachment(id, Normal, DefaultTimeout)
+	err = cloudApiService.WaitForApiGatewayAppAttachment(id, Normal, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -115,12 +124,12 @@ func resourceAliyunApigatewayAppAttachmentDelete(d *schema.ResourceData, meta in
 		return cloudApiClient.RemoveAppsAuthorities(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, NotFoundAuthorization) {
+		if IsProductError(err, connectivity.CLOUDAPICode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(cloudApiService.WaitForApiGatewayAppAttachment(d.Id(), Deleted, DefaultLongTimeout))
+	return WrapError(cloudApiService.WaitForApiGatewayAppAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -48,6 +48,11 @@ func SkipTestAccAlicloudApigatewayAppAttachment(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -17,6 +17,9 @@ func resourceAlicloudCasCertificate() *schema.Resource {
 		Create: resourceAlicloudCasCreate,
 		Read:   resourceAlicloudCasRead,
 		Delete: resourceAlicloudCasDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudCasImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
@@ -31,9 +34,10 @@ func resourceAlicloudCasCertificate() *schema.Resource {
 				ForceNew: true,
 			},
 			"key": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 		},
 	}
@@ -68,6 +72,26 @@ func resourceAlicloudCasCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceAlicloudCasRead(d, meta)
 }
 
+// resourceAlicloudCasImport imports a certificate by its ID, and sets its cert and key which are not returned by the
+// certificate list read by resourceAlicloudCasRead.
+func resourceAlicloudCasImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*connectivity.AliyunClient)
+
+	request := cas.CreateDescribeUserCertificateDetailRequest()
+	request.CertId = requests.Integer(d.Id())
+	raw, err := client.WithCasClient(func(casClient *cas.Client) (interface{}, error) {
+		return casClient.DescribeUserCertificateDetail(request)
+	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw)
+	response, _ := raw.(*cas.DescribeUserCertificateDetailResponse)
+	d.Set("cert", response.Cert)
+	d.Set("key", response.Key)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAlicloudCasRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
@@ -100,7 +124,7 @@ func resourceAlicloudCasDelete(d *schema.ResourceData, meta interface{}) error {
 		})
 
 		if err != nil {
-			if IsExceptedErrors(err, []string{CertNotExist}) {
+			if IsProductError(err, connectivity.CASCode, ErrorNotFound) {
 				return nil
 			}
 			return resource.RetryableError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR))
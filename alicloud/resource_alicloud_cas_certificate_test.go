@@ -38,7 +38,7 @@ func testSweepCasCertificate(region string) error {
 	req.RegionId = client.RegionId
 	req.ShowSize = requests.NewInteger(PageSizeLarge)
 	req.CurrentPage = requests.NewInteger(1)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
@@ -118,6 +118,11 @@ func TestAccAlicloudCasCertificate_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("alicloud_cas_certificate.cert", "name", fmt.Sprintf("tf_testAcc_%v", randInt)),
 				),
 			},
+			{
+				ResourceName:      "alicloud_cas_certificate.cert",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -18,6 +18,13 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 		Read:   resourceAlicloudCdnDomainRead,
 		Update: resourceAlicloudCdnDomainUpdate,
 		Delete: resourceAlicloudCdnDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(6 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -217,12 +224,14 @@ func resourceAlicloudCdnDomain() *schema.Resource {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
+							Sensitive:    true,
 							ValidateFunc: validateCdnAuthKey,
 						},
 						"slave_key": {
 							Type:         schema.TypeString,
 							Optional:     true,
 							Computed:     true,
+							Sensitive:    true,
 							ValidateFunc: validateCdnAuthKey,
 						},
 						"timeout": {
@@ -840,7 +849,7 @@ func certificateConfigUpdate(client *connectivity.AliyunClient, d *schema.Resour
 	}
 	d.SetPartial("certificate_config")
 	if okServerCertificate && args.ServerCertificateStatus != "off" {
-		err := WaitForServerCertificate(client, d.Id(), args.ServerCertificate, 360)
+		err := WaitForServerCertificate(client, d.Id(), args.ServerCertificate, timeoutSeconds(d, schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("Timeout waiting for Cdn server certificate. Error: %#v", err)
 		}
@@ -980,7 +989,9 @@ func WaitForDomainStatus(Id string, status Status, timeout int, meta interface{}
 		if timeout <= 0 {
 			return GetTimeErrorFromString(GetTimeoutMessage("Domain", string(status)))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(meta.(*connectivity.AliyunClient), DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -1002,7 +1013,9 @@ func WaitForServerCertificate(client *connectivity.AliyunClient, Id string, serv
 		if timeout <= 0 {
 			return GetTimeErrorFromString(GetTimeoutMessage("ServerCertificate", string(serverCertificate)))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
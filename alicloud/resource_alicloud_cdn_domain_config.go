@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cdn"
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -19,6 +20,12 @@ func resourceAlicloudCdnDomainConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Type:         schema.TypeString,
@@ -86,7 +93,7 @@ func resourceAlicloudCdnDomainConfigCreate(d *schema.ResourceData, meta interfac
 
 	d.SetId(fmt.Sprintf("%s:%s", request.DomainNames, d.Get("function_name").(string)))
 
-	err = cdnService.WaitForCdnDomain(d.Get("domain_name").(string), Online, DefaultTimeoutMedium)
+	err = cdnService.WaitForCdnDomain(d.Get("domain_name").(string), Online, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -149,7 +156,7 @@ func resourceAlicloudCdnDomainConfigDelete(d *schema.ResourceData, meta interfac
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	return WrapError(cdnService.WaitForCdnDomain(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(cdnService.WaitForCdnDomain(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func expirationCdnDomainConfigHash(v interface{}) int {
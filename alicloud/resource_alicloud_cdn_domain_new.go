@@ -21,6 +21,13 @@ func resourceAlicloudCdnDomainNew() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
 				Type:         schema.TypeString,
@@ -167,7 +174,7 @@ func resourceAlicloudCdnDomainCreateNew(d *schema.ResourceData, meta interface{}
 
 	d.SetId(request.DomainName)
 
-	err = cdnService.WaitForCdnDomain(d.Id(), Online, DefaultTimeoutMedium)
+	err = cdnService.WaitForCdnDomain(d.Id(), Online, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -209,7 +216,7 @@ func resourceAlicloudCdnDomainUpdateNew(d *schema.ResourceData, meta interface{}
 			}
 			addDebug(request.GetActionName(), raw)
 
-			err = cdnService.WaitForCdnDomain(d.Id(), Online, DefaultTimeoutMedium)
+			err = cdnService.WaitForCdnDomain(d.Id(), Online, timeoutSeconds(d, schema.TimeoutUpdate))
 			if err != nil {
 				return WrapError(err)
 			}
@@ -313,13 +320,13 @@ func resourceAlicloudCdnDomainDeleteNew(d *schema.ResourceData, meta interface{}
 	})
 
 	if err != nil {
-		if IsExceptedError(err, InvalidDomainNotFound) {
+		if IsProductError(err, connectivity.CDNCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(cdnService.WaitForCdnDomain(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(cdnService.WaitForCdnDomain(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func certificateConfigUpdateNew(client *connectivity.AliyunClient, d *schema.ResourceData) error {
@@ -387,7 +394,7 @@ func certificateConfigUpdateNew(client *connectivity.AliyunClient, d *schema.Res
 	}
 	d.SetPartial("certificate_config")
 	if okServerCertificate && request.ServerCertificateStatus != "off" {
-		err := cdnService.WaitForServerCertificateNew(d.Id(), request.ServerCertificate, DefaultTimeout)
+		err := cdnService.WaitForServerCertificateNew(d.Id(), request.ServerCertificate, timeoutSeconds(d, schema.TimeoutUpdate))
 		if err != nil {
 			return WrapError(err)
 		}
@@ -108,6 +108,11 @@ func TestAccAlicloudCdnDomain_basic(t *testing.T) {
 						fmt.Sprintf("tf-testacc%d.xiaozhu.com", rand)),
 				),
 			},
+			{
+				ResourceName:      "alicloud_cdn_domain.domain",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -134,10 +134,10 @@ func resourceAlicloudCenBandwidthLimitUpdate(d *schema.ResourceData, meta interf
 
 		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 
-			stateConf := BuildStateConf([]string{"Modifying"}, []string{"Active"}, d.Timeout(schema.TimeoutUpdate), 3*time.Second, cenService.CenBandwidthLimitStateRefreshFunc(d.Id(), []string{}))
+			stateConf := BuildStateConf(client, []string{"Modifying"}, []string{"Active"}, d.Timeout(schema.TimeoutUpdate), 3*time.Second, cenService.CenBandwidthLimitStateRefreshFunc(d.Id(), []string{}))
 
 			if _, err = stateConf.WaitForState(); err != nil {
-				if IsExceptedError(err, CenThrottlingUser) {
+				if IsThrottledError(err) {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -179,10 +179,10 @@ func resourceAlicloudCenBandwidthLimitDelete(d *schema.ResourceData, meta interf
 	}
 
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		stateConf := BuildStateConf([]string{"Active", "Modifying"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenBandwidthLimitStateRefreshFunc(d.Id(), []string{}))
+		stateConf := BuildStateConf(client, []string{"Active", "Modifying"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenBandwidthLimitStateRefreshFunc(d.Id(), []string{}))
 
 		_, err = stateConf.WaitForState()
-		if IsExceptedError(err, CenThrottlingUser) {
+		if IsThrottledError(err) {
 			return resource.RetryableError(err)
 		}
 		return resource.NonRetryableError(err)
@@ -24,6 +24,12 @@ func resourceAlicloudCenBandwidthPackage() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bandwidth": {
 				Type:     schema.TypeInt,
@@ -133,11 +139,15 @@ func resourceAlicloudCenBandwidthPackageCreate(d *schema.ResourceData, meta inte
 	bandwidth, _ := strconv.Atoi(string(request.Bandwidth))
 
 	req := *request
+	resend := clientTokenWait(client)
 	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
 			return cbnClient.CreateCenBandwidthPackage(&req)
 		})
 		if err != nil {
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
 			if IsExceptedError(err, OperationBlocking) {
 				return resource.RetryableError(err)
 			}
@@ -152,7 +162,7 @@ func resourceAlicloudCenBandwidthPackageCreate(d *schema.ResourceData, meta inte
 	}
 
 	d.SetId(response.CenBandwidthPackageId)
-	err = cenService.WaitForCenBandwidthPackage(d.Id(), Idle, bandwidth, DefaultCenTimeout)
+	err = cenService.WaitForCenBandwidthPackage(d.Id(), Idle, bandwidth, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -237,7 +247,7 @@ func resourceAlicloudCenBandwidthPackageUpdate(d *schema.ResourceData, meta inte
 		}
 		addDebug(modifyCenBandwidthPackageSpecRequest.GetActionName(), raw)
 		// modify function may delay for a while
-		if err := cenService.WaitForCenBandwidthPackage(d.Id(), Idle, bandwidth, DefaultCenTimeout); err != nil {
+		if err := cenService.WaitForCenBandwidthPackage(d.Id(), Idle, bandwidth, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		d.SetPartial("bandwidth")
@@ -276,7 +286,7 @@ func resourceAlicloudCenBandwidthPackageDelete(d *schema.ResourceData, meta inte
 	}
 
 	// set bandwidth "-1" here to use WaitForCenBandwidthPackage, actually determined by status.
-	return WrapError(cenService.WaitForCenBandwidthPackage(d.Id(), Deleted, -1, DefaultCenTimeout))
+	return WrapError(cenService.WaitForCenBandwidthPackage(d.Id(), Deleted, -1, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func convertGeographicRegionId(regionId string) (retStr string) {
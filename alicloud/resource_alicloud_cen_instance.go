@@ -73,12 +73,16 @@ func resourceAlicloudCenInstanceCreate(d *schema.ResourceData, meta interface{})
 	request.ClientToken = buildClientToken(request.GetActionName())
 
 	var response *cbn.CreateCenResponse
+	resend := clientTokenWait(client)
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		req := *request
 		raw, err := client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
 			return cbnClient.CreateCen(&req)
 		})
 		if err != nil {
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
 			if IsExceptedErrors(err, []string{OperationBlocking, UnknownError}) {
 				return resource.RetryableError(err)
 			}
@@ -93,7 +97,7 @@ func resourceAlicloudCenInstanceCreate(d *schema.ResourceData, meta interface{})
 	addDebug(request.GetActionName(), response)
 	d.SetId(response.CenId)
 
-	stateConf := BuildStateConf([]string{"Creating"}, []string{"Active"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, cenService.CenInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
+	stateConf := BuildStateConf(client, []string{"Creating"}, []string{"Active"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, cenService.CenInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 
 	if _, err := stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
@@ -158,14 +162,14 @@ func resourceAlicloudCenInstanceDelete(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		if IsExceptedError(err, ParameterCenInstanceIdNotExist) {
+		if IsProductError(err, connectivity.CENCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 
-	stateConf := BuildStateConf([]string{"Creating", "Active", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenInstanceStateRefreshFunc(d.Id(), []string{}))
+	stateConf := BuildStateConf(client, []string{"Creating", "Active", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenInstanceStateRefreshFunc(d.Id(), []string{}))
 
 	if _, err = stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
@@ -21,6 +21,11 @@ func resourceAlicloudCenInstanceAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCenTimeoutLong * time.Second),
+			Delete: schema.DefaultTimeout(DefaultCenTimeoutLong * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -86,7 +91,7 @@ func resourceAlicloudCenInstanceAttachmentCreate(d *schema.ResourceData, meta in
 
 	d.SetId(cenId + COLON_SEPARATED + instanceId)
 
-	if err := cenService.WaitForCenInstanceAttachment(d.Id(), Status("Attached"), DefaultCenTimeoutLong); err != nil {
+	if err := cenService.WaitForCenInstanceAttachment(d.Id(), Status("Attached"), timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	return resourceAlicloudCenInstanceAttachmentRead(d, meta)
@@ -149,11 +154,11 @@ func resourceAlicloudCenInstanceAttachmentDelete(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		if IsExceptedError(err, ParameterInstanceIdNotExist) {
+		if IsProductError(err, connectivity.CENCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(cenService.WaitForCenInstanceAttachment(d.Id(), Deleted, DefaultCenTimeoutLong))
+	return WrapError(cenService.WaitForCenInstanceAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
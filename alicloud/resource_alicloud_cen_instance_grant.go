@@ -20,6 +20,10 @@ func resourceAlicloudCenInstanceGrant() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cen_id": {
 				Type:     schema.TypeString,
@@ -138,7 +142,7 @@ func resourceAlicloudCenInstanceGrantDelete(d *schema.ResourceData, meta interfa
 		})
 
 		if err != nil {
-			if IsExceptedError(err, InvalidInstanceIdNotFound) {
+			if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 				return nil
 			} else if IsExceptedErrors(err, []string{IncorrectStatus, TaskConflict}) {
 				return resource.RetryableError(err)
@@ -153,5 +157,5 @@ func resourceAlicloudCenInstanceGrantDelete(d *schema.ResourceData, meta interfa
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(vpcService.WaitForCenInstanceGrant(d.Id(), Deleted, DefaultCenTimeout))
+	return WrapError(vpcService.WaitForCenInstanceGrant(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
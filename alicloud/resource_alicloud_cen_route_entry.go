@@ -18,6 +18,11 @@ func resourceAlicloudCenRouteEntry() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultCenTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultCenTimeoutLong * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -76,7 +81,7 @@ func resourceAlicloudCenRouteEntryCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(cenId + COLON_SEPARATED + vtbId + COLON_SEPARATED + cidr)
 
-	err = cenService.WaitForCenRouterEntry(d.Id(), PUBLISHED, DefaultCenTimeout)
+	err = cenService.WaitForCenRouterEntry(d.Id(), PUBLISHED, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -153,11 +158,11 @@ func resourceAlicloudCenRouteEntryDelete(d *schema.ResourceData, meta interface{
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{NotFoundRoute, InstanceNotExistMsg}) {
+		if IsProductError(err, connectivity.CENCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DataDefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 
 	}
-	return WrapError(cenService.WaitForCenRouterEntry(d.Id(), Deleted, DefaultCenTimeoutLong))
+	return WrapError(cenService.WaitForCenRouterEntry(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
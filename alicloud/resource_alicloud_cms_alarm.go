@@ -25,6 +25,10 @@ func resourceAlicloudCmsAlarm() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(102 * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -279,7 +283,7 @@ func resourceAlicloudCmsAlarmUpdate(d *schema.ResourceData, meta interface{}) er
 			return fmt.Errorf("Disableing alarm got an error: %#v", err)
 		}
 	}
-	if err := cmsService.WaitForCmsAlarm(d.Id(), d.Get("enabled").(bool), 102); err != nil {
+	if err := cmsService.WaitForCmsAlarm(d.Id(), d.Get("enabled").(bool), timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 		return err
 	}
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 
@@ -22,6 +23,11 @@ func resourceAliyunCommonBandwidthPackage() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -78,16 +84,19 @@ func resourceAliyunCommonBandwidthPackageCreate(d *schema.ResourceData, meta int
 	request.InternetChargeType = d.Get("internet_charge_type").(string)
 	request.Ratio = requests.NewInteger(d.Get("ratio").(int))
 	request.ClientToken = buildClientToken(request.GetActionName())
-	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-		return vpcClient.CreateCommonBandwidthPackage(request)
-	})
+	// Bandwidth package names are optional and not unique, so there is nothing to look the package up by.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.CreateCommonBandwidthPackage(request)
+		})
+	}, nil)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_common_bandwidth_package", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 	response, _ := raw.(*vpc.CreateCommonBandwidthPackageResponse)
 	d.SetId(response.BandwidthPackageId)
-	if err = vpcService.WaitForCommonBandwidthPackage(response.BandwidthPackageId, Available, DefaultTimeout); err != nil {
+	if err = vpcService.WaitForCommonBandwidthPackage(response.BandwidthPackageId, Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -180,5 +189,5 @@ func resourceAliyunCommonBandwidthPackageDelete(d *schema.ResourceData, meta int
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(vpcService.WaitForCommonBandwidthPackage(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForCommonBandwidthPackage(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
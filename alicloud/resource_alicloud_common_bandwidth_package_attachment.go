@@ -17,6 +17,12 @@ func resourceAliyunCommonBandwidthPackageAttachment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bandwidth_package_id": {
 				Type:     schema.TypeString,
@@ -48,7 +54,7 @@ func resourceAliyunCommonBandwidthPackageAttachmentCreate(d *schema.ResourceData
 	addDebug(request.GetActionName(), raw)
 	//check the common bandwidth package attachment
 	d.SetId(request.BandwidthPackageId + COLON_SEPARATED + request.IpInstanceId)
-	if err := vpcService.WaitForCommonBandwidthPackageAttachment(d.Id(), Available, 5*DefaultTimeout); err != nil {
+	if err := vpcService.WaitForCommonBandwidthPackageAttachment(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunCommonBandwidthPackageAttachmentRead(d, meta)
@@ -107,5 +113,5 @@ func resourceAliyunCommonBandwidthPackageAttachmentDelete(d *schema.ResourceData
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForCommonBandwidthPackageAttachment(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForCommonBandwidthPackageAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
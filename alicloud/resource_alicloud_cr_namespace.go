@@ -42,7 +42,7 @@ func resourceAlicloudCRNamespace() *schema.Resource {
 
 func resourceAlicloudCRNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	namespaceName := d.Get("name").(string)
 
@@ -72,7 +72,7 @@ func resourceAlicloudCRNamespaceCreate(d *schema.ResourceData, meta interface{})
 
 func resourceAlicloudCRNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	if d.HasChange("auto_create") || d.HasChange("default_visibility") {
 		payload := &crUpdateNamespaceRequestPayload{}
@@ -127,7 +127,7 @@ func resourceAlicloudCRNamespaceRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceAlicloudCRNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	crService := CrService{client}
 
 	return resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -157,7 +157,9 @@ func resourceAlicloudCRNamespaceDelete(d *schema.ResourceData, meta interface{})
 			return resource.RetryableError(WrapErrorf(err, DefaultErrorMsg, d.Id(), req.GetActionName(), AlibabaCloudSdkGoERROR))
 		}
 
-		time.Sleep(15 * time.Second)
+		if err := sleepContext(client, 15*time.Second); err != nil {
+			return resource.NonRetryableError(WrapError(err))
+		}
 		return resource.RetryableError(WrapError(Error("DeleteNamespace timeout")))
 	})
 }
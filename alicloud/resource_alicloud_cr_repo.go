@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr"
@@ -19,6 +21,10 @@ func resourceAlicloudCRRepo() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:         schema.TypeString,
@@ -184,11 +190,11 @@ func resourceAlicloudCRRepoDelete(d *schema.ResourceData, meta interface{}) erro
 		return crClient.DeleteRepo(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, ErrorRepoNotExist) {
+		if IsProductError(err, connectivity.CRCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	return WrapError(crService.WaitForCrRepo(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(crService.WaitForCrRepo(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -23,6 +23,11 @@ func resourceAlicloudCSApplication() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cluster_name": {
 				Type:     schema.TypeString,
@@ -146,7 +151,7 @@ func resourceAlicloudCSApplicationCreate(d *schema.ResourceData, meta interface{
 		}
 		args.Environment = env
 	}
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	if err := invoker.Run(func() error {
 		cluster, certs, err := csService.GetContainerClusterAndCertsByName(clusterName)
 		if err == nil {
@@ -161,7 +166,7 @@ func resourceAlicloudCSApplicationCreate(d *schema.ResourceData, meta interface{
 
 	d.SetId(fmt.Sprintf("%s%s%s", clusterName, COLON_SEPARATED, args.Name))
 
-	if err := csService.WaitForContainerApplication(clusterName, args.Name, Running, DefaultTimeoutMedium); err != nil {
+	if err := csService.WaitForContainerApplication(clusterName, args.Name, Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("Waitting for container application %#v got an error: %#v", cs.Running, err)
 	}
 
@@ -235,7 +240,7 @@ func resourceAlicloudCSApplicationUpdate(d *schema.ResourceData, meta interface{
 	csService := CsService{client}
 	parts := strings.Split(d.Id(), COLON_SEPARATED)
 	clusterName := parts[0]
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	args := &cs.ProjectUpdationArgs{
 		Name:        parts[1],
 		Description: d.Get("description").(string),
@@ -321,7 +326,7 @@ func resourceAlicloudCSApplicationUpdate(d *schema.ResourceData, meta interface{
 					}); err != nil {
 						return fmt.Errorf("Rollbacking container application blue-green got an error: %#v", err)
 					}
-					if err := csService.WaitForContainerApplication(parts[0], parts[1], Running, DefaultTimeoutMedium); err != nil {
+					if err := csService.WaitForContainerApplication(parts[0], parts[1], Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 						return fmt.Errorf("After rolling back blue-green project, waitting for container application %#v got an error: %#v", Running, err)
 					}
 					continue
@@ -347,7 +352,7 @@ func resourceAlicloudCSApplicationUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
-	if err := csService.WaitForContainerApplication(parts[0], parts[1], Running, DefaultTimeoutMedium); err != nil {
+	if err := csService.WaitForContainerApplication(parts[0], parts[1], Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 		return fmt.Errorf("After updating, waitting for container application %#v got an error: %#v", Running, err)
 	}
 
@@ -363,7 +368,7 @@ func resourceAlicloudCSApplicationDelete(d *schema.ResourceData, meta interface{
 	clusterName := parts[0]
 
 	appName := parts[1]
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	return resource.Retry(3*time.Minute, func() *resource.RetryError {
 		err := invoker.Run(func() error {
@@ -376,7 +381,7 @@ func resourceAlicloudCSApplicationDelete(d *schema.ResourceData, meta interface{
 			return err
 		})
 		if err != nil {
-			if IsExceptedError(err, ApplicationNotFound) {
+			if IsProductError(err, connectivity.CONTAINCode, ErrorNotFound) || IsExceptedError(err, ApplicationNotFound) {
 				return nil
 			}
 			if !IsExceptedError(err, ApplicationErrorIgnore) && !IsExceptedError(err, AliyunGoClientFailure) {
@@ -399,7 +404,7 @@ func resourceAlicloudCSApplicationDelete(d *schema.ResourceData, meta interface{
 			project, _ = raw.(cs.GetProjectResponse)
 			return nil
 		}); err != nil {
-			if IsExceptedError(err, ApplicationNotFound) || IsExceptedError(err, ApplicationErrorIgnore) {
+			if IsProductError(err, connectivity.CONTAINCode, ErrorNotFound) || IsExceptedErrors(err, []string{ApplicationNotFound, ApplicationErrorIgnore}) {
 				return nil
 			}
 			return resource.NonRetryableError(fmt.Errorf("Getting container application %s got an error: %#v.", appName, err))
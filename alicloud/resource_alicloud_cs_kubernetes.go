@@ -41,6 +41,11 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -480,7 +485,7 @@ func resourceAlicloudCSKubernetes() *schema.Resource {
 
 func resourceAlicloudCSKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	if isMultiAZ, err := isMultiAZClusterAndCheck(d); err != nil {
 		return err
@@ -524,7 +529,7 @@ func resourceAlicloudCSKubernetesCreate(d *schema.ResourceData, meta interface{}
 
 	if err := invoker.Run(func() error {
 		_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
-			return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, 3600)
+			return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, timeoutSeconds(d, schema.TimeoutCreate))
 		})
 		return err
 	}); err != nil {
@@ -537,7 +542,7 @@ func resourceAlicloudCSKubernetesCreate(d *schema.ResourceData, meta interface{}
 func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	d.Partial(true)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	if d.HasChange("worker_numbers") && !d.IsNewResource() {
 
 		workerNumbers := expandIntList(d.Get("worker_numbers").([]interface{}))
@@ -571,7 +576,7 @@ func resourceAlicloudCSKubernetesUpdate(d *schema.ResourceData, meta interface{}
 
 		if err := invoker.Run(func() error {
 			_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
-				return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, 3600)
+				return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, timeoutSeconds(d, schema.TimeoutUpdate))
 			})
 			return err
 		}); err != nil {
@@ -610,7 +615,7 @@ func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{})
 	client := meta.(*connectivity.AliyunClient)
 
 	var cluster cs.KubernetesCluster
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	if err := invoker.Run(func() error {
 		raw, e := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 			return csClient.DescribeKubernetesCluster(d.Id())
@@ -680,7 +685,9 @@ func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{})
 						return nil
 					}
 				}
-				time.Sleep(5 * time.Second)
+				if err := sleepContext(client, 5*time.Second); err != nil {
+					return resource.NonRetryableError(WrapError(err))
+				}
 				return resource.RetryableError(fmt.Errorf("[ERROR] There is no any nodes in kubernetes cluster %s.", d.Id()))
 			})
 			if err != nil {
@@ -812,7 +819,7 @@ func resourceAlicloudCSKubernetesRead(d *schema.ResourceData, meta interface{})
 
 func resourceAlicloudCSKubernetesDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	var cluster cs.ClusterType
 	return resource.Retry(30*time.Minute, func() *resource.RetryError {
 		if err := invoker.Run(func() error {
@@ -847,7 +854,9 @@ func resourceAlicloudCSKubernetesDelete(d *schema.ResourceData, meta interface{}
 		}
 
 		if string(cluster.State) == string(Deleting) {
-			time.Sleep(10 * time.Second)
+			if err := sleepContext(client, 10*time.Second); err != nil {
+				return resource.NonRetryableError(WrapError(err))
+			}
 		}
 
 		return resource.RetryableError(fmt.Errorf("Delete Kubernetes Cluster timeout."))
@@ -28,6 +28,11 @@ func resourceAlicloudCSManagedKubernetes() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -263,7 +268,7 @@ func resourceAlicloudCSManagedKubernetes() *schema.Resource {
 
 func resourceAlicloudCSManagedKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 
 	args, err := buildManagedKubernetesArgs(d, meta)
 	if err != nil {
@@ -285,7 +290,7 @@ func resourceAlicloudCSManagedKubernetesCreate(d *schema.ResourceData, meta inte
 
 	if err := invoker.Run(func() error {
 		_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
-			return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, 3600)
+			return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, timeoutSeconds(d, schema.TimeoutCreate))
 		})
 		return err
 	}); err != nil {
@@ -298,7 +303,7 @@ func resourceAlicloudCSManagedKubernetesCreate(d *schema.ResourceData, meta inte
 func resourceAlicloudCSManagedKubernetesUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	d.Partial(true)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	if d.HasChange("worker_number") || d.HasChange("worker_numbers") {
 		var scaleSize int
 		if d.HasChange("worker_number") {
@@ -350,7 +355,7 @@ func resourceAlicloudCSManagedKubernetesUpdate(d *schema.ResourceData, meta inte
 
 		if err := invoker.Run(func() error {
 			_, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
-				return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, 3600)
+				return nil, csClient.WaitForClusterAsyn(d.Id(), cs.Running, timeoutSeconds(d, schema.TimeoutUpdate))
 			})
 			return err
 		}); err != nil {
@@ -389,7 +394,7 @@ func resourceAlicloudCSManagedKubernetesRead(d *schema.ResourceData, meta interf
 	client := meta.(*connectivity.AliyunClient)
 
 	var cluster cs.KubernetesCluster
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	if err := invoker.Run(func() error {
 		raw, e := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 			return csClient.DescribeKubernetesCluster(d.Id())
@@ -458,7 +463,9 @@ func resourceAlicloudCSManagedKubernetesRead(d *schema.ResourceData, meta interf
 						return nil
 					}
 				}
-				time.Sleep(5 * time.Second)
+				if err := sleepContext(client, 5*time.Second); err != nil {
+					return resource.NonRetryableError(WrapError(err))
+				}
 				return resource.RetryableError(fmt.Errorf("[ERROR] There is no any nodes in ManagedKubernetes cluster %s.", d.Id()))
 			})
 			if err != nil {
@@ -535,7 +542,7 @@ func resourceAlicloudCSManagedKubernetesRead(d *schema.ResourceData, meta interf
 
 func resourceAlicloudCSManagedKubernetesDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	var cluster cs.ClusterType
 	return resource.Retry(30*time.Minute, func() *resource.RetryError {
 		if err := invoker.Run(func() error {
@@ -570,7 +577,9 @@ func resourceAlicloudCSManagedKubernetesDelete(d *schema.ResourceData, meta inte
 		}
 
 		if string(cluster.State) == string(Deleting) {
-			time.Sleep(10 * time.Second)
+			if err := sleepContext(client, 10*time.Second); err != nil {
+				return resource.NonRetryableError(WrapError(err))
+			}
 		}
 
 		return resource.RetryableError(fmt.Errorf("Delete ManagedKubernetes Cluster timeout."))
@@ -21,6 +21,12 @@ func resourceAlicloudDBAccount() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -70,7 +76,7 @@ func resourceAlicloudDBAccountCreate(d *schema.ResourceData, meta interface{}) e
 		request.AccountDescription = v.(string)
 	}
 	// wait instance running before modifying
-	if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeoutMedium); err != nil {
+	if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -93,7 +99,7 @@ func resourceAlicloudDBAccountCreate(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(fmt.Sprintf("%s%s%s", request.DBInstanceId, COLON_SEPARATED, request.AccountName))
 
-	if err := rdsService.WaitForAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
+	if err := rdsService.WaitForAccount(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -129,7 +135,7 @@ func resourceAlicloudDBAccountUpdate(d *schema.ResourceData, meta interface{}) e
 	accountName := parts[1]
 
 	if d.HasChange("description") {
-		if err := rdsService.WaitForAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
+		if err := rdsService.WaitForAccount(d.Id(), Available, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		request := rds.CreateModifyAccountDescriptionRequest()
@@ -148,7 +154,7 @@ func resourceAlicloudDBAccountUpdate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	if d.HasChange("password") {
-		if err := rdsService.WaitForAccount(d.Id(), Available, DefaultTimeoutMedium); err != nil {
+		if err := rdsService.WaitForAccount(d.Id(), Available, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		request := rds.CreateResetAccountPasswordRequest()
@@ -184,10 +190,10 @@ func resourceAlicloudDBAccountDelete(d *schema.ResourceData, meta interface{}) e
 	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DeleteAccount(request)
 	})
-	if err != nil && !IsExceptedError(err, InvalidAccountNameNotFound) {
+	if err != nil && !IsProductError(err, connectivity.RDSCode, ErrorNotFound) {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return rdsService.WaitForAccount(d.Id(), Deleted, DefaultTimeoutMedium)
+	return rdsService.WaitForAccount(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete))
 }
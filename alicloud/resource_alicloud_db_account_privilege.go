@@ -20,6 +20,12 @@ func resourceAlicloudDBAccountPrivilege() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -59,7 +65,7 @@ func resourceAlicloudDBAccountPrivilegeCreate(d *schema.ResourceData, meta inter
 	privilege := d.Get("privilege").(string)
 	dbList := d.Get("db_names").(*schema.Set).List()
 	// wait instance running before granting
-	if err := rsdService.WaitForDBInstance(instanceId, Running, DefaultLongTimeout); err != nil {
+	if err := rsdService.WaitForDBInstance(instanceId, Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	d.SetId(fmt.Sprintf("%s%s%s%s%s", instanceId, COLON_SEPARATED, account, COLON_SEPARATED, privilege))
@@ -67,7 +73,7 @@ func resourceAlicloudDBAccountPrivilegeCreate(d *schema.ResourceData, meta inter
 	if len(dbList) > 0 {
 		for _, db := range dbList {
 			if err := resource.Retry(10*time.Minute, func() *resource.RetryError {
-				if err := rsdService.GrantAccountPrivilege(d.Id(), db.(string)); err != nil {
+				if err := rsdService.GrantAccountPrivilege(d.Id(), db.(string), timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 					if IsExceptedErrors(err, OperationDeniedDBStatus) {
 						return resource.RetryableError(err)
 					}
@@ -129,11 +135,11 @@ func resourceAlicloudDBAccountPrivilegeUpdate(d *schema.ResourceData, meta inter
 
 		if len(remove) > 0 {
 			// wait instance running before revoking
-			if err := rdsService.WaitForDBInstance(parts[0], Running, DefaultTimeoutMedium); err != nil {
+			if err := rdsService.WaitForDBInstance(parts[0], Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 				return WrapError(err)
 			}
 			for _, db := range remove {
-				if err := rdsService.RevokeAccountPrivilege(d.Id(), db.(string)); err != nil {
+				if err := rdsService.RevokeAccountPrivilege(d.Id(), db.(string), timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 					return WrapError(err)
 				}
 			}
@@ -141,11 +147,11 @@ func resourceAlicloudDBAccountPrivilegeUpdate(d *schema.ResourceData, meta inter
 
 		if len(add) > 0 {
 			// wait instance running before granting
-			if err := rdsService.WaitForDBInstance(parts[0], Running, DefaultTimeoutMedium); err != nil {
+			if err := rdsService.WaitForDBInstance(parts[0], Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 				return WrapError(err)
 			}
 			for _, db := range add {
-				if err := rdsService.GrantAccountPrivilege(d.Id(), db.(string)); err != nil {
+				if err := rdsService.GrantAccountPrivilege(d.Id(), db.(string), timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 					return WrapError(err)
 				}
 			}
@@ -177,12 +183,12 @@ func resourceAlicloudDBAccountPrivilegeDelete(d *schema.ResourceData, meta inter
 		for _, pri := range object.DatabasePrivileges.DatabasePrivilege {
 			if pri.AccountPrivilege == parts[2] {
 				dbName = pri.DBName
-				if err := rdsService.RevokeAccountPrivilege(d.Id(), pri.DBName); err != nil {
+				if err := rdsService.RevokeAccountPrivilege(d.Id(), pri.DBName, timeoutSeconds(d, schema.TimeoutDelete)); err != nil {
 					return WrapError(err)
 				}
 			}
 		}
 	}
 
-	return rdsService.WaitForAccountPrivilege(d.Id(), dbName, Deleted, DefaultTimeoutMedium)
+	return rdsService.WaitForAccountPrivilege(d.Id(), dbName, Deleted, timeoutSeconds(d, schema.TimeoutDelete))
 }
@@ -23,6 +23,11 @@ func resourceAlicloudDBBackupPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -148,11 +153,11 @@ func resourceAlicloudDBBackupPolicyUpdate(d *schema.ResourceData, meta interface
 
 	if update {
 		// wait instance running before modifying
-		if err := rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
+		if err := rdsService.WaitForDBInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-			if err := rdsService.ModifyDBBackupPolicy(d.Id(), backupTime, backupPeriod, retentionPeriod, backupLog, logBackupRetentionPeriod); err != nil {
+			if err := rdsService.ModifyDBBackupPolicy(d.Id(), backupTime, backupPeriod, retentionPeriod, backupLog, logBackupRetentionPeriod, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 				if IsExceptedErrors(err, OperationDeniedDBStatus) {
 					return resource.RetryableError(err)
 				}
@@ -186,5 +191,5 @@ func resourceAlicloudDBBackupPolicyDelete(d *schema.ResourceData, meta interface
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return rdsService.WaitForDBInstance(d.Id(), Running, DefaultTimeoutMedium)
+	return rdsService.WaitForDBInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutDelete))
 }
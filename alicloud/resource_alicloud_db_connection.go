@@ -28,6 +28,12 @@ func resourceAlicloudDBConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -95,11 +101,11 @@ func resourceAlicloudDBConnectionCreate(d *schema.ResourceData, meta interface{}
 
 	d.SetId(fmt.Sprintf("%s%s%s", instanceId, COLON_SEPARATED, request.ConnectionStringPrefix))
 
-	if err := rdsService.WaitForDBConnection(d.Id(), Available, DefaultTimeoutMedium); err != nil {
+	if err := rdsService.WaitForDBConnection(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	// wait instance running after allocating
-	if err := rdsService.WaitForDBInstance(instanceId, Running, DefaultTimeoutMedium); err != nil {
+	if err := rdsService.WaitForDBInstance(instanceId, Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -177,7 +183,7 @@ func resourceAlicloudDBConnectionUpdate(d *schema.ResourceData, meta interface{}
 		}
 
 		// wait instance running after modifying
-		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeoutMedium); err != nil {
+		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -225,5 +231,5 @@ func resourceAlicloudDBConnectionDelete(d *schema.ResourceData, meta interface{}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return rdsService.WaitForDBConnection(d.Id(), Deleted, DefaultTimeoutMedium)
+	return rdsService.WaitForDBConnection(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete))
 }
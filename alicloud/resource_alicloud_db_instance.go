@@ -33,6 +33,8 @@ func resourceAlicloudDBInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"engine": {
 				Type:         schema.TypeString,
@@ -165,6 +167,7 @@ func resourceAlicloudDBInstance() *schema.Resource {
 			"master_user_password": {
 				Type:       schema.TypeString,
 				Optional:   true,
+				Sensitive:  true,
 				Deprecated: "Field 'master_user_password' has been deprecated from provider version 1.5.0. New resource 'alicloud_db_account' field 'password' replaces it.",
 			},
 
@@ -268,7 +271,8 @@ func resourceAlicloudDBInstance() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -287,9 +291,12 @@ func resourceAlicloudDBInstanceCreate(d *schema.ResourceData, meta interface{})
 		return WrapError(err)
 	}
 
-	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
-		return rdsClient.CreateDBInstance(request)
-	})
+	// DescribeDBInstances can not filter by the token and the description is not unique.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return rdsClient.CreateDBInstance(request)
+		})
+	}, nil)
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -299,7 +306,7 @@ func resourceAlicloudDBInstanceCreate(d *schema.ResourceData, meta interface{})
 	d.SetId(response.DBInstanceId)
 
 	// wait instance status change from Creating to running
-	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 5*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
+	stateConf := BuildStateConf(client, []string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 5*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 	if _, err := stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
@@ -311,7 +318,7 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 	client := meta.(*connectivity.AliyunClient)
 	rdsService := RdsService{client}
 	d.Partial(true)
-	stateConf := BuildStateConf([]string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
+	stateConf := BuildStateConf(client, []string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 
 	if d.HasChange("parameters") {
 		if err := rdsService.ModifyParameters(d, "parameters"); err != nil {
@@ -430,7 +437,7 @@ func resourceAlicloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 			ipstr = LOCAL_HOST_IP
 		}
 
-		if err := rdsService.ModifyDBSecurityIps(d.Id(), ipstr); err != nil {
+		if err := rdsService.ModifyDBSecurityIps(d.Id(), ipstr, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		d.SetPartial("security_ips")
@@ -508,8 +515,8 @@ func resourceAlicloudDBInstanceRead(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return WrapError(err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", rdsService.tagsToMap(tags))
+	if err := setResourceTags(client, d, rdsService.tagsToMap(tags)); err != nil {
+		return WrapError(err)
 	}
 
 	monitoringPeriod, err := rdsService.DescribeDbInstanceMonitor(d.Id())
@@ -605,7 +612,7 @@ func resourceAlicloudDBInstanceDelete(d *schema.ResourceData, meta interface{})
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	stateConf := BuildStateConf([]string{"Creating", "Running", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 1*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{}))
+	stateConf := BuildStateConf(client, []string{"Creating", "Running", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 1*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{}))
 	if _, err = stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
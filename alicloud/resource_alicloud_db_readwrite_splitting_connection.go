@@ -26,6 +26,12 @@ func resourceAlicloudDBReadWriteSplittingConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(600 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -114,7 +120,7 @@ func resourceAlicloudDBReadWriteSplittingConnectionCreate(d *schema.ResourceData
 
 	// wait read write splitting connection ready after creation
 	// for it may take up to 10 hours to create a readonly instance
-	if err := rdsService.WaitForDBReadWriteSplitting(request.DBInstanceId, "", 60*60*10); err != nil {
+	if err := rdsService.WaitForDBReadWriteSplitting(request.DBInstanceId, "", timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -126,7 +132,8 @@ func resourceAlicloudDBReadWriteSplittingConnectionRead(d *schema.ResourceData,
 	client := meta.(*connectivity.AliyunClient)
 	rdsService := RdsService{client}
 
-	err := rdsService.WaitForDBReadWriteSplitting(d.Id(), "", DefaultLongTimeout)
+	// The connection may still be allocating when it is read right after creating.
+	err := rdsService.WaitForDBReadWriteSplitting(d.Id(), "", timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -204,7 +211,7 @@ func resourceAlicloudDBReadWriteSplittingConnectionUpdate(d *schema.ResourceData
 
 	if update {
 		// wait instance running before modifying
-		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, 60*60); err != nil {
+		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 
@@ -225,7 +232,7 @@ func resourceAlicloudDBReadWriteSplittingConnectionUpdate(d *schema.ResourceData
 		}
 
 		// wait instance running after modifying
-		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeoutMedium); err != nil {
+		if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -259,5 +266,5 @@ func resourceAlicloudDBReadWriteSplittingConnectionDelete(d *schema.ResourceData
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(rdsService.WaitForDBReadWriteSplitting(d.Id(), Deleted, DefaultLongTimeout))
+	return WrapError(rdsService.WaitForDBReadWriteSplitting(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -14,6 +14,9 @@ func resourceAlicloudDdoscooInstance() *schema.Resource {
 		Read:   resourceAlicloudDdoscooInstanceRead,
 		Update: resourceAlicloudDdoscooInstanceUpdate,
 		Delete: resourceAlicloudDdoscooInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -57,9 +60,12 @@ func resourceAlicloudDdoscooInstanceCreate(d *schema.ResourceData, meta interfac
 
 	request := buildDdoscooCreateRequest(d, meta)
 
-	raw, err := client.WithBssopenapiClient(func(bssopenapiClient *bssopenapi.Client) (interface{}, error) {
-		return bssopenapiClient.CreateInstance(request)
-	})
+	// BSS orders can not be looked up before the order id is known.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithBssopenapiClient(func(bssopenapiClient *bssopenapi.Client) (interface{}, error) {
+			return bssopenapiClient.CreateInstance(request)
+		})
+	}, nil)
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_ddoscoo_instance", request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -183,7 +189,7 @@ func resourceAlicloudDdoscooInstanceDelete(d *schema.ResourceData, meta interfac
 		return ddoscooClient.ReleaseInstance(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, DdoscooInstanceNotFound) {
+		if IsProductError(err, connectivity.DDOSCOOCode, ErrorNotFound) {
 			return nil
 		}
 
@@ -226,6 +232,7 @@ func buildDdoscooCreateRequest(d *schema.ResourceData, meta interface{}) *bssope
 			Value: d.Get("service_bandwidth").(string),
 		},
 	}
+	request.ClientToken = buildClientToken(request.GetActionName())
 
 	return request
 }
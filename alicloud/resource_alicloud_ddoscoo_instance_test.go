@@ -123,6 +123,12 @@ func TestAccAlicloudDdoscooInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("alicloud_ddoscoo_instance.foo", "domain_count", "50"),
 				),
 			},
+			{
+				ResourceName:            "alicloud_ddoscoo_instance.foo",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"period"},
+			},
 			{
 				Config: testAccDdoscooInstanceConfig_name(randInt),
 				Check: resource.ComposeTestCheckFunc(
@@ -20,6 +20,13 @@ func resourceAliyunDisk() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -88,7 +95,8 @@ func resourceAliyunDisk() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -131,16 +139,19 @@ func resourceAliyunDiskCreate(d *schema.ResourceData, meta interface{}) error {
 		request.Encrypted = requests.NewBoolean(v.(bool))
 	}
 	request.ClientToken = buildClientToken(request.GetActionName())
-	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-		return ecsClient.CreateDisk(request)
-	})
+	// Disk names are not unique and DescribeDisks has no token filter.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.CreateDisk(request)
+		})
+	}, nil)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_disk", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 	response, _ := raw.(*ecs.CreateDiskResponse)
 	d.SetId(response.DiskId)
-	if err := ecsService.WaitForDisk(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := ecsService.WaitForDisk(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -176,8 +187,8 @@ func resourceAliyunDiskRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil && !NotFoundError(err) {
 		return WrapError(err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
+	if err := setResourceTags(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
 	}
 
 	return nil
@@ -294,5 +305,5 @@ func resourceAliyunDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ecsService.WaitForDisk(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ecsService.WaitForDisk(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
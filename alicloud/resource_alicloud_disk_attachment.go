@@ -17,6 +17,14 @@ func resourceAliyunDiskAttachment() *schema.Resource {
 		Create: resourceAliyunDiskAttachmentCreate,
 		Read:   resourceAliyunDiskAttachmentRead,
 		Delete: resourceAliyunDiskAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: importStateParseResourceId(2),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -74,7 +82,7 @@ func resourceAliyunDiskAttachmentCreate(d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(request.DiskId + ":" + request.InstanceId)
 
-	if err := ecsService.WaitForDiskAttachment(d.Id(), DiskInUse, DefaultTimeout); err != nil {
+	if err := ecsService.WaitForDiskAttachment(d.Id(), DiskInUse, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	newDisk, err := ecsService.DescribeDisk(diskID)
@@ -131,13 +139,13 @@ func resourceAliyunDiskAttachmentDelete(d *schema.ResourceData, meta interface{}
 	request.InstanceId = parts[1]
 	request.DiskId = parts[0]
 
+	wait := dependencyWait(client, 3*time.Second)
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachDisk(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, DiskInvalidOperation) {
-				time.Sleep(3 * time.Second)
+			if IsExceptedErrors(err, DiskInvalidOperation) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -148,5 +156,5 @@ func resourceAliyunDiskAttachmentDelete(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ecsService.WaitForDiskAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ecsService.WaitForDiskAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
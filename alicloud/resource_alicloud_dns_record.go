@@ -174,7 +174,7 @@ func resourceAlicloudDnsRecordDelete(d *schema.ResourceData, meta interface{}) e
 			return dnsClient.DeleteDomainRecord(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{DomainRecordNotBelongToUser}) {
+			if IsProductError(err, connectivity.DNSCode, ErrorNotFound) {
 				return nil
 			}
 			if IsExceptedErrors(err, []string{RecordForbiddenDNSChange, DnsInternalError}) {
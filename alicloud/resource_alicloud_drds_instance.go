@@ -92,9 +92,12 @@ func resourceAliCloudDRDSInstanceCreate(d *schema.ResourceData, meta interface{}
 	if request.PayType == string(PrePaid) {
 		request.PayType = "drdsPre"
 	}
-	raw, err := client.WithDrdsClient(func(drdsClient *drds.Client) (interface{}, error) {
-		return drdsClient.CreateDrdsInstance(request)
-	})
+	// DRDS descriptions are not unique and there is no token filter.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithDrdsClient(func(drdsClient *drds.Client) (interface{}, error) {
+			return drdsClient.CreateDrdsInstance(request)
+		})
+	}, nil)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_drds_instance", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
@@ -108,7 +111,7 @@ func resourceAliCloudDRDSInstanceCreate(d *schema.ResourceData, meta interface{}
 
 	// wait instance status change from Creating to running
 	//0 -> running for drds,1->creating,2->exception,3->expire,4->release,5->locked
-	stateConf := BuildStateConf([]string{"1"}, []string{"0"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{"2"}))
+	stateConf := BuildStateConf(client, []string{"1"}, []string{"0"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{"2"}))
 	if _, err := stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
@@ -164,7 +167,7 @@ func resourceAliCloudDRDSInstanceDelete(d *schema.ResourceData, meta interface{}
 		return drdsClient.RemoveDrdsInstance(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, InvalidDRDSInstanceIdNotFound) {
+		if IsProductError(err, connectivity.DRDSCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -175,7 +178,7 @@ func resourceAliCloudDRDSInstanceDelete(d *schema.ResourceData, meta interface{}
 		return WrapError(Error("failed to delete instance timeout "+"and got an error: %#v", err))
 	}
 
-	stateConf := BuildStateConf([]string{"0", "1", "2", "3", "4", "5", "6"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{}))
+	stateConf := BuildStateConf(client, []string{"0", "1", "2", "3", "4", "5", "6"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{}))
 	if _, err = stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
@@ -20,6 +20,12 @@ func resourceAliyunEip() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -73,6 +79,8 @@ func resourceAliyunEip() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -99,9 +107,12 @@ func resourceAliyunEipCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	request.ClientToken = buildClientToken(request.GetActionName())
 
-	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-		return vpcClient.AllocateEipAddress(request)
-	})
+	// An EIP carries nothing but its allocation id to look it up by.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.AllocateEipAddress(request)
+		})
+	}, nil)
 	if err != nil {
 		if IsExceptedError(err, COMMODITYINVALID_COMPONENT) && request.InternetChargeType == string(PayByBandwidth) {
 			return WrapErrorf(err, "Your account is international and it can only create '%s' elastic IP. Please change it and try again. %s", PayByTraffic, AlibabaCloudSdkGoERROR)
@@ -111,7 +122,7 @@ func resourceAliyunEipCreate(d *schema.ResourceData, meta interface{}) error {
 	addDebug(request.GetActionName(), raw)
 	response, _ := raw.(*vpc.AllocateEipAddressResponse)
 	d.SetId(response.AllocationId)
-	err = vpcService.WaitForEip(d.Id(), Available, DefaultTimeoutMedium)
+	err = vpcService.WaitForEip(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -141,11 +152,20 @@ func resourceAliyunEipRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("ip_address", object.IpAddress)
 	d.Set("status", object.Status)
 
-	return nil
+	tagService := TagService{client}
+	tags, err := tagService.DescribeTags(VpcTagProduct, TagResourceEip, d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	return WrapError(setResourceTags(client, d, tags))
 }
 
 func resourceAliyunEipUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, VpcTagProduct, TagResourceEip); err != nil {
+		return WrapError(err)
+	}
 	update := false
 	request := vpc.CreateModifyEipAddressAttributeRequest()
 	request.AllocationId = d.Id()
@@ -188,7 +208,7 @@ func resourceAliyunEipDelete(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			if IsExceptedError(err, EipIncorrectStatus) {
 				return resource.RetryableError(err)
-			} else if IsExceptedError(err, AllocationIdNotFound) {
+			} else if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -199,5 +219,5 @@ func resourceAliyunEipDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForEip(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpcService.WaitForEip(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
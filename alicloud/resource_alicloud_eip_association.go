@@ -15,6 +15,14 @@ func resourceAliyunEipAssociation() *schema.Resource {
 		Create: resourceAliyunEipAssociationCreate,
 		Read:   resourceAliyunEipAssociationRead,
 		Delete: resourceAliyunEipAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: importStateParseResourceId(2),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allocation_id": {
@@ -83,12 +91,14 @@ func resourceAliyunEipAssociationCreate(d *schema.ResourceData, meta interface{}
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_eip_association", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	if err := vpcService.WaitForEip(request.AllocationId, InUse, 60); err != nil {
+	if err := vpcService.WaitForEip(request.AllocationId, InUse, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	// There is at least 30 seconds delay for ecs instance
 	if request.InstanceType == EcsInstance {
-		time.Sleep(30 * time.Second)
+		if err := sleepContext(client, 30*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	d.SetId(request.AllocationId + ":" + request.InstanceId)
@@ -157,5 +167,5 @@ func resourceAliyunEipAssociationDelete(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForEipAssociation(d.Id(), Available, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForEipAssociation(d.Id(), Available, timeoutSeconds(d, schema.TimeoutDelete)))
 }
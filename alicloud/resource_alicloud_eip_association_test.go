@@ -70,6 +70,12 @@ func TestAccAlicloudEipAssociationBasic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_ip_address"},
+			},
 		},
 	})
 }
@@ -21,6 +21,10 @@ func resourceAlicloudEssAlarm() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -135,7 +139,7 @@ func resourceAliyunEssAlarmCreate(d *schema.ResourceData, meta interface{}) erro
 			return essClient.CreateAlarm(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, EssThrottling) {
+			if IsThrottledError(err) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -320,13 +324,13 @@ func resourceAliyunEssAlarmDelete(d *schema.ResourceData, meta interface{}) erro
 		return essClient.DeleteAlarm(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, InvalidEssAlarmTaskNotFound) {
+		if IsProductError(err, connectivity.ESSCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	return WrapError(essService.WaitForEssAlarm(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForEssAlarm(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func buildAlicloudEssAlarmArgs(d *schema.ResourceData) (*ess.CreateAlarmRequest, error) {
@@ -22,6 +22,11 @@ func resourceAlicloudEssAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -65,7 +70,7 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 		if object.LifecycleState == string(Inactive) {
 			return WrapError(Error("Scaling group current status is %s, please active it before attaching or removing ECS instances.", object.LifecycleState))
 		} else {
-			if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, DefaultTimeout); err != nil {
+			if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -111,10 +116,12 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 
 						if len(autoAdded) > 0 {
 							if d.Get("force").(bool) {
-								if err := essService.EssRemoveInstances(d.Id(), autoAdded); err != nil {
+								if err := essService.EssRemoveInstances(d.Id(), autoAdded, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+									return resource.NonRetryableError(WrapError(err))
+								}
+								if err := sleepContext(client, 5*time.Second); err != nil {
 									return resource.NonRetryableError(WrapError(err))
 								}
-								time.Sleep(5)
 								return resource.RetryableError(WrapError(err))
 							} else {
 								return resource.NonRetryableError(WrapError(Error("To attach the instances, the total capacity will be greater than the scaling group max size %d."+
@@ -128,7 +135,9 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 						}
 					}
 					if IsExceptedError(err, ScalingActivityInProgress) {
-						time.Sleep(5)
+						if err := sleepContext(client, 5*time.Second); err != nil {
+							return resource.NonRetryableError(WrapError(err))
+						}
 						return resource.RetryableError(WrapError(err))
 					}
 					return resource.NonRetryableError(WrapError(err))
@@ -160,7 +169,7 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 			}
 		}
 		if len(remove) > 0 {
-			if err := essService.EssRemoveInstances(d.Id(), convertArrayInterfaceToArrayString(remove)); err != nil {
+			if err := essService.EssRemoveInstances(d.Id(), convertArrayInterfaceToArrayString(remove), timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -211,7 +220,7 @@ func resourceAliyunEssAttachmentDelete(d *schema.ResourceData, meta interface{})
 		return WrapError(err)
 	}
 
-	if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, DefaultTimeout); err != nil {
+	if err := essService.WaitForEssScalingGroup(object.ScalingGroupId, Active, timeoutSeconds(d, schema.TimeoutDelete)); err != nil {
 		if NotFoundError(err) {
 			return nil
 		}
@@ -242,16 +251,20 @@ func resourceAliyunEssAttachmentDelete(d *schema.ResourceData, meta interface{})
 				}
 			}
 			if IsExceptedErrors(err, []string{ScalingActivityInProgress, IncorrectScalingGroupStatus}) {
-				time.Sleep(5)
+				if err := sleepContext(client, 5*time.Second); err != nil {
+					return resource.NonRetryableError(WrapError(err))
+				}
 				return resource.RetryableError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR))
 			}
-			if IsExceptedError(err, InvalidScalingGroupIdNotFound) {
+			if IsProductError(err, connectivity.ESSCode, ErrorNotFound) {
 				return nil
 			}
 			return resource.NonRetryableError(WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw)
-		time.Sleep(3 * time.Second)
+		if err := sleepContext(client, 3*time.Second); err != nil {
+			return resource.NonRetryableError(WrapError(err))
+		}
 		instances, err := essService.DescribeEssAttachment(d.Id(), removed)
 		if err != nil {
 			if NotFoundError(err) {
@@ -272,7 +285,7 @@ func resourceAliyunEssAttachmentDelete(d *schema.ResourceData, meta interface{})
 		return WrapError(err)
 	}
 
-	return WrapError(essService.WaitForEssAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForEssAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func convertArrayInterfaceToArrayString(elm []interface{}) (arr []string) {
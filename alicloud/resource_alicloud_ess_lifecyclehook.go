@@ -20,6 +20,10 @@ func resourceAlicloudEssLifecycleHook() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -73,7 +77,7 @@ func resourceAliyunEssLifeCycleHookCreate(d *schema.ResourceData, meta interface
 			return essClient.CreateLifecycleHook(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, EssThrottling) {
+			if IsThrottledError(err) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -161,14 +165,14 @@ func resourceAliyunEssLifeCycleHookDelete(d *schema.ResourceData, meta interface
 		return essClient.DeleteLifecycleHook(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidLifecycleHookIdNotFound}) {
+		if IsProductError(err, connectivity.ESSCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(essService.WaitForEssLifecycleHook(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForEssLifecycleHook(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 
 }
 
@@ -24,6 +24,11 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"active": {
 				Type:     schema.TypeBool,
@@ -473,7 +478,7 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 				}
 				addDebug(request.GetActionName(), raw)
-				if err := essService.WaitForEssScalingGroup(sgId, Active, DefaultTimeout); err != nil {
+				if err := essService.WaitForEssScalingGroup(sgId, Active, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 					return WrapError(err)
 				}
 
@@ -490,7 +495,7 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 				}
 				addDebug(request.GetActionName(), raw)
-				if err := essService.WaitForEssScalingGroup(sgId, Inactive, DefaultTimeout); err != nil {
+				if err := essService.WaitForEssScalingGroup(sgId, Inactive, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 					return WrapError(err)
 				}
 			}
@@ -600,13 +605,13 @@ func resourceAliyunEssScalingConfigurationDelete(d *schema.ResourceData, meta in
 			})
 
 			if err != nil {
-				if IsExceptedError(err, InvalidScalingGroupIdNotFound) {
+				if IsProductError(err, connectivity.ESSCode, ErrorNotFound) {
 					return nil
 				}
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 			}
 			addDebug(request.GetActionName(), raw)
-			return WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, DefaultTimeout))
+			return WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 		}
 		return WrapError(Error("Current scaling configuration %s is the last configuration for the scaling group %s. Please launch a new "+
 			"active scaling configuration or set 'force_delete' to 'true' to delete it with deleting its scaling group.", d.Id(), object.ScalingGroupId))
@@ -620,14 +625,14 @@ func resourceAliyunEssScalingConfigurationDelete(d *schema.ResourceData, meta in
 	})
 
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidScalingGroupIdNotFound, InvalidEssScalingConfigurationIdNotFound}) {
+		if IsProductError(err, connectivity.ESSCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), rawDeleteScalingConfiguration)
 
-	return WrapError(essService.WaitForScalingConfiguration(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForScalingConfiguration(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func buildAlicloudEssScalingConfigurationArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingConfigurationRequest, error) {
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
@@ -24,6 +23,11 @@ func resourceAlicloudEssScalingGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"min_size": {
 				Type:         schema.TypeInt,
@@ -97,24 +101,40 @@ func resourceAliyunEssScalingGroupCreate(d *schema.ResourceData, meta interface{
 	client := meta.(*connectivity.AliyunClient)
 	essService := EssService{client}
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
-			return essClient.CreateScalingGroup(request)
-		})
-		if err != nil {
-			if IsExceptedError(err, EssThrottling) {
-				return resource.RetryableError(err)
+	var lookup createLookup
+	if request.ScalingGroupName != "" {
+		// The scaling group names are unique in a region, so the group found by the name is the one of this request.
+		lookup = func() (interface{}, error) {
+			describe := ess.CreateDescribeScalingGroupsRequest()
+			describe.RegionId = client.RegionId
+			describe.ScalingGroupName = request.ScalingGroupName
+			raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+				return essClient.DescribeScalingGroups(describe)
+			})
+			if err != nil {
+				return nil, err
+			}
+			addDebug(describe.GetActionName(), raw)
+			for _, group := range raw.(*ess.DescribeScalingGroupsResponse).ScalingGroups.ScalingGroup {
+				if group.ScalingGroupName == request.ScalingGroupName {
+					return &ess.CreateScalingGroupResponse{ScalingGroupId: group.ScalingGroupId}, nil
+				}
 			}
-			return resource.NonRetryableError(err)
+			return nil, nil
 		}
-		addDebug(request.GetActionName(), raw)
-		response, _ := raw.(*ess.CreateScalingGroupResponse)
-		d.SetId(response.ScalingGroupId)
-		return nil
-	}); err != nil {
+	}
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+			return essClient.CreateScalingGroup(request)
+		})
+	}, lookup)
+	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_ess_scalinggroup", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	if err := essService.WaitForEssScalingGroup(d.Id(), Inactive, DefaultTimeout); err != nil {
+	addDebug(request.GetActionName(), raw)
+	response, _ := raw.(*ess.CreateScalingGroupResponse)
+	d.SetId(response.ScalingGroupId)
+	if err := essService.WaitForEssScalingGroup(d.Id(), Inactive, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -258,14 +278,14 @@ func resourceAliyunEssScalingGroupDelete(d *schema.ResourceData, meta interface{
 	})
 
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidScalingGroupIdNotFound}) {
+		if IsProductError(err, connectivity.ESSCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func buildAlicloudEssScalingGroupArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingGroupRequest, error) {
@@ -292,7 +312,7 @@ func buildAlicloudEssScalingGroupArgs(d *schema.ResourceData, meta interface{})
 
 	if lbs, ok := d.GetOk("loadbalancer_ids"); ok {
 		for _, lb := range lbs.(*schema.Set).List() {
-			if err := slbService.WaitForSlb(lb.(string), Active, DefaultTimeout); err != nil {
+			if err := slbService.WaitForSlb(lb.(string), Active, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 				return nil, WrapError(err)
 			}
 		}
@@ -302,6 +322,7 @@ func buildAlicloudEssScalingGroupArgs(d *schema.ResourceData, meta interface{})
 	if v, ok := d.GetOk("multi_az_policy"); ok && v.(string) != "" {
 		request.MultiAZPolicy = v.(string)
 	}
+	request.ClientToken = buildClientToken(request.GetActionName())
 
 	return request, nil
 }
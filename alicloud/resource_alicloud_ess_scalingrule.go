@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
@@ -18,6 +19,11 @@ func resourceAlicloudEssScalingRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -120,13 +126,13 @@ func resourceAliyunEssScalingRuleDelete(d *schema.ResourceData, meta interface{}
 		return essClient.DeleteScalingRule(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidScalingRuleIdNotFound}) {
+		if IsProductError(err, connectivity.ESSCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	return WrapError(essService.WaitForEssScalingRule(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForEssScalingRule(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func resourceAliyunEssScalingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,6 +19,10 @@ func resourceAlicloudEssScheduledTask() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"scheduled_action": {
 				Type:     schema.TypeString,
@@ -173,14 +179,14 @@ func resourceAliyunEssScheduledTaskDelete(d *schema.ResourceData, meta interface
 		return essClient.DeleteScheduledTask(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, InvalidScheduledTaskIdNotFound) {
+		if IsProductError(err, connectivity.ESSCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(essService.WaitForEssScheduledTask(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(essService.WaitForEssScheduledTask(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func buildAlicloudEssScheduledTaskArgs(d *schema.ResourceData) *ess.CreateScheduledTaskRequest {
@@ -23,6 +23,10 @@ func resourceAlicloudFCFunction() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"service": {
 				Type:     schema.TypeString,
@@ -284,13 +288,13 @@ func resourceAlicloudFCFunctionDelete(d *schema.ResourceData, meta interface{})
 		})
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{ServiceNotFound, FunctionNotFound}) {
+		if IsProductError(err, connectivity.FCCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteFunction", FcGoSdk)
 	}
 	addDebug("DeleteFunction", raw)
-	return WrapError(fcService.WaitForFcFunction(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(fcService.WaitForFcFunction(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func getFunctionCode(d *schema.ResourceData) (*fc.Code, error) {
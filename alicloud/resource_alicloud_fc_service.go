@@ -22,6 +22,10 @@ func resourceAlicloudFCService() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:          schema.TypeString,
@@ -275,13 +279,13 @@ func resourceAlicloudFCServiceDelete(d *schema.ResourceData, meta interface{}) e
 		})
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{ServiceNotFound}) {
+		if IsProductError(err, connectivity.FCCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteService", FcGoSdk)
 	}
 	addDebug("DeleteService", raw)
-	return WrapError(fcService.WaitForFcService(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(fcService.WaitForFcService(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 
 }
 
@@ -16,6 +16,15 @@ func resourceAliyunForwardEntry() *schema.Resource {
 		Read:   resourceAliyunForwardEntryRead,
 		Update: resourceAliyunForwardEntryUpdate,
 		Delete: resourceAliyunForwardEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: importStateParseResourceId(2),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"forward_table_id": {
@@ -94,7 +103,7 @@ func resourceAliyunForwardEntryCreate(d *schema.ResourceData, meta interface{})
 	addDebug(request.GetActionName(), raw)
 	response, _ := raw.(*vpc.CreateForwardEntryResponse)
 	d.SetId(request.ForwardTableId + COLON_SEPARATED + response.ForwardEntryId)
-	if err := vpcService.WaitForForwardEntry(d.Id(), Available, DefaultTimeoutMedium); err != nil {
+	if err := vpcService.WaitForForwardEntry(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunForwardEntryRead(d, meta)
@@ -174,7 +183,7 @@ func resourceAliyunForwardEntryUpdate(d *schema.ResourceData, meta interface{})
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	if err := vpcService.WaitForForwardEntry(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForForwardEntry(d.Id(), Available, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunForwardEntryRead(d, meta)
@@ -209,10 +218,10 @@ func resourceAliyunForwardEntryDelete(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidForwardEntryIdNotFound, InvalidForwardTableIdNotFound}) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 			return nil
 		}
 		WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForForwardEntry(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpcService.WaitForForwardEntry(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
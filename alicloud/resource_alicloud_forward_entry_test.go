@@ -42,6 +42,11 @@ func TestAccAlicloudForwardBasic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccForwardEntryConfig_external_ip(rand),
 				Check: resource.ComposeTestCheckFunc(
@@ -124,11 +124,15 @@ func resourceAlicloudGpdbInstanceCreate(d *schema.ResourceData, meta interface{}
 		return WrapError(err)
 	}
 	var raw interface{}
+	resend := clientTokenWait(client)
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithGpdbClient(func(client *gpdb.Client) (interface{}, error) {
 			return client.CreateDBInstance(request)
 		})
 		if err != nil {
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
 			if IsExceptedError(err, InvalidGpdbConcurrentOperate) {
 				return resource.RetryableError(err)
 			}
@@ -143,7 +147,7 @@ func resourceAlicloudGpdbInstanceCreate(d *schema.ResourceData, meta interface{}
 	}
 	d.SetId(response.DBInstanceId)
 
-	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 10*time.Minute, gpdbService.GpdbInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
+	stateConf := BuildStateConf(client, []string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 10*time.Minute, gpdbService.GpdbInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 
 	if _, err := stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
@@ -214,7 +218,7 @@ func resourceAlicloudGpdbInstanceDelete(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidGpdbInstanceIdNotFound, InvalidGpdbNameNotFound}) {
+		if IsProductError(err, connectivity.GPDBCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
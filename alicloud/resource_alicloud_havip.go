@@ -20,6 +20,11 @@ func resourceAliyunHaVip() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(2 * DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -54,15 +59,18 @@ func resourceAliyunHaVipCreate(d *schema.ResourceData, meta interface{}) error {
 	request.Description = d.Get("description").(string)
 	request.ClientToken = buildClientToken(request.GetActionName())
 
-	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-		return vpcClient.CreateHaVip(request)
-	})
+	// HaVip descriptions are not unique.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.CreateHaVip(request)
+		})
+	}, nil)
 	if err != nil {
 		return err
 	}
 	havip, _ := raw.(*vpc.CreateHaVipResponse)
 	d.SetId(havip.HaVipId)
-	if err := haVipService.WaitForHaVip(havip.HaVipId, Available, 2*DefaultTimeout); err != nil {
+	if err := haVipService.WaitForHaVip(havip.HaVipId, Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("WaitHaVip %s got error: %#v, %s", Available, err, havip.HaVipId)
 	}
 	return resourceAliyunHaVipRead(d, meta)
@@ -109,7 +117,7 @@ func resourceAliyunHaVipDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	haVipService := HaVipService{client}
 
-	if err := haVipService.WaitForHaVip(d.Id(), Available, 2*DefaultTimeout); err != nil {
+	if err := haVipService.WaitForHaVip(d.Id(), Available, timeoutSeconds(d, schema.TimeoutDelete)); err != nil {
 		return fmt.Errorf("WaitHaVip %s got error: %#v, %s", Available, err, d.Id())
 	}
 	request := vpc.CreateDeleteHaVipRequest()
@@ -120,7 +128,7 @@ func resourceAliyunHaVipDelete(d *schema.ResourceData, meta interface{}) error {
 			return vpcClient.DeleteHaVip(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, InvalidHaVipIdNotFound) {
+			if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 				return nil
 			}
 			return resource.NonRetryableError(err)
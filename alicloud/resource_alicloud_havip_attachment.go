@@ -18,6 +18,11 @@ func resourceAliyunHaVipAttachment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"havip_id": {
 				Type:     schema.TypeString,
@@ -57,7 +62,7 @@ func resourceAliyunHaVipAttachmentCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 	//check the havip attachment
-	if err := haVipService.WaitForHaVipAttachment(args.HaVipId, args.InstanceId, 5*DefaultTimeout); err != nil {
+	if err := haVipService.WaitForHaVipAttachment(args.HaVipId, args.InstanceId, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return fmt.Errorf("Wait for havip attachment got error: %#v", err)
 	}
 
@@ -0,0 +1,428 @@
+package alicloud
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func resourceAliyunImage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunImageCreate,
+		Read:   resourceAliyunImageRead,
+		Update: resourceAliyunImageUpdate,
+		Delete: resourceAliyunImageDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
+		CustomizeDiff: setTagsAllDiff,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"snapshot_id", "disk_device_mapping", "import_disk_device_mapping"},
+			},
+			"snapshot_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"instance_id", "disk_device_mapping", "import_disk_device_mapping"},
+			},
+			"disk_device_mapping": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"instance_id", "snapshot_id", "import_disk_device_mapping"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+						"disk_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"system", "data"}),
+						},
+						"device": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"import_disk_device_mapping": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      17,
+				ConflictsWith: []string{"instance_id", "snapshot_id", "disk_device_mapping"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oss_bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"oss_object": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"qcow2", "VHD", "RAW"}),
+						},
+						"disk_image_size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"device": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"os_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"linux", "windows"}),
+			},
+			"platform": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"architecture": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"x86_64", "i386"}),
+			},
+			"image_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(2, 256),
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"os_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+func resourceAliyunImageCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	if mappings, ok := d.GetOk("import_disk_device_mapping"); ok {
+		request := ecs.CreateImportImageRequest()
+		request.RegionId = client.RegionId
+		request.ImageName = d.Get("name").(string)
+		request.Description = d.Get("description").(string)
+		request.OSType = d.Get("os_type").(string)
+		request.Platform = d.Get("platform").(string)
+		request.Architecture = d.Get("architecture").(string)
+		request.RoleName = d.Get("role_name").(string)
+		var diskDeviceMappings []ecs.ImportImageDiskDeviceMapping
+		for _, m := range mappings.([]interface{}) {
+			mapping := m.(map[string]interface{})
+			diskDeviceMapping := ecs.ImportImageDiskDeviceMapping{
+				OSSBucket: mapping["oss_bucket"].(string),
+				OSSObject: mapping["oss_object"].(string),
+				Format:    mapping["format"].(string),
+				Device:    mapping["device"].(string),
+			}
+			if size := mapping["disk_image_size"].(int); size > 0 {
+				diskDeviceMapping.DiskImageSize = strconv.Itoa(size)
+			}
+			diskDeviceMappings = append(diskDeviceMappings, diskDeviceMapping)
+		}
+		request.DiskDeviceMapping = &diskDeviceMappings
+
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ImportImage(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "alicloud_image", request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw)
+		response, _ := raw.(*ecs.ImportImageResponse)
+		d.SetId(response.ImageId)
+	} else {
+		request := ecs.CreateCreateImageRequest()
+		request.RegionId = client.RegionId
+		request.InstanceId = d.Get("instance_id").(string)
+		request.SnapshotId = d.Get("snapshot_id").(string)
+		request.ImageName = d.Get("name").(string)
+		request.Description = d.Get("description").(string)
+		request.Platform = d.Get("platform").(string)
+		request.Architecture = d.Get("architecture").(string)
+		request.ImageVersion = d.Get("image_version").(string)
+		request.ResourceGroupId = d.Get("resource_group_id").(string)
+		request.ClientToken = buildClientToken(request.GetActionName())
+		if mappings, ok := d.GetOk("disk_device_mapping"); ok {
+			var diskDeviceMappings []ecs.CreateImageDiskDeviceMapping
+			for _, m := range mappings.([]interface{}) {
+				mapping := m.(map[string]interface{})
+				diskDeviceMapping := ecs.CreateImageDiskDeviceMapping{
+					SnapshotId: mapping["snapshot_id"].(string),
+					DiskType:   mapping["disk_type"].(string),
+					Device:     mapping["device"].(string),
+				}
+				if size := mapping["size"].(int); size > 0 {
+					diskDeviceMapping.Size = strconv.Itoa(size)
+				}
+				diskDeviceMappings = append(diskDeviceMappings, diskDeviceMapping)
+			}
+			request.DiskDeviceMapping = &diskDeviceMappings
+		}
+		if request.InstanceId == "" && request.SnapshotId == "" && request.DiskDeviceMapping == nil {
+			return WrapError(Error("One of instance_id, snapshot_id, disk_device_mapping and import_disk_device_mapping must be set."))
+		}
+
+		var lookup createLookup
+		if request.ImageName != "" {
+			// The image names are unique in a region, so an image with the name can only be created by this request.
+			lookup = func() (interface{}, error) {
+				describe := ecs.CreateDescribeImagesRequest()
+				describe.RegionId = client.RegionId
+				describe.ImageName = request.ImageName
+				describe.ImageOwnerAlias = "self"
+				describe.Status = strings.Join([]string{string(ImageCreating), string(ImageWaiting), string(ImageAvailable),
+					string(ImageUnAvailable), string(ImageCreateFailed)}, ",")
+				raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+					return ecsClient.DescribeImages(describe)
+				})
+				if err != nil {
+					return nil, err
+				}
+				addDebug(describe.GetActionName(), raw)
+				for _, image := range raw.(*ecs.DescribeImagesResponse).Images.Image {
+					if image.ImageName == request.ImageName {
+						return &ecs.CreateImageResponse{ImageId: image.ImageId}, nil
+					}
+				}
+				return nil, nil
+			}
+		}
+		raw, err := createWithClientToken(client, func() (interface{}, error) {
+			return client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+				return ecsClient.CreateImage(request)
+			})
+		}, lookup)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "alicloud_image", request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw)
+		response, _ := raw.(*ecs.CreateImageResponse)
+		d.SetId(response.ImageId)
+	}
+
+	stateConf := BuildStateConf(client, []string{string(ImageCreating), string(ImageWaiting)}, []string{string(ImageAvailable)},
+		d.Timeout(schema.TimeoutCreate), 5*time.Second,
+		ecsService.ImageStateRefreshFunc(d.Id(), []string{string(ImageCreateFailed), string(ImageUnAvailable)}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAliyunImageUpdate(d, meta)
+}
+
+func resourceAliyunImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	object, err := ecsService.DescribeImage(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("name", object.ImageName)
+	d.Set("description", object.Description)
+	d.Set("os_type", object.OSType)
+	d.Set("platform", object.Platform)
+	d.Set("architecture", object.Architecture)
+	d.Set("image_version", object.ImageVersion)
+	d.Set("resource_group_id", object.ResourceGroupId)
+	d.Set("os_name", object.OSNameEn)
+	d.Set("size", object.Size)
+	d.Set("status", object.Status)
+
+	var mappings []map[string]interface{}
+	for _, m := range object.DiskDeviceMappings.DiskDeviceMapping {
+		size, _ := strconv.Atoi(m.Size)
+		mappings = append(mappings, map[string]interface{}{
+			"snapshot_id": m.SnapshotId,
+			"size":        size,
+			"disk_type":   m.Type,
+			"device":      m.Device,
+		})
+	}
+	if err := d.Set("disk_device_mapping", mappings); err != nil {
+		return WrapError(err)
+	}
+
+	tags, err := ecsService.DescribeTags(d.Id(), TagResourceImage)
+	if err != nil && !NotFoundError(err) {
+		return WrapError(err)
+	}
+	if err := setResourceTags(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
+
+	return nil
+}
+
+func resourceAliyunImageUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	if err := setTags(client, TagResourceImage, d); err != nil {
+		return WrapError(err)
+	}
+
+	if err := modifyImageAttribute(client, d); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAliyunImageRead(d, meta)
+}
+
+// modifyImageAttribute updates the name and the description of an image, which are set by the creation.
+func modifyImageAttribute(client *connectivity.AliyunClient, d *schema.ResourceData) error {
+	if d.IsNewResource() || !(d.HasChange("name") || d.HasChange("description")) {
+		return nil
+	}
+	request := ecs.CreateModifyImageAttributeRequest()
+	request.RegionId = client.RegionId
+	request.ImageId = d.Id()
+	request.ImageName = d.Get("name").(string)
+	request.Description = d.Get("description").(string)
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ModifyImageAttribute(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw)
+	return nil
+}
+
+// resourceAliyunImageDelete deletes an image created or copied by the provider.
+func resourceAliyunImageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	request := ecs.CreateDeleteImageRequest()
+	request.RegionId = client.RegionId
+	request.ImageId = d.Id()
+	// The images which are still used by instances are deleted only by force.
+	request.Force = requests.NewBoolean(d.Get("force").(bool))
+
+	var raw interface{}
+	var err error
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DeleteImage(request)
+		})
+		if err != nil {
+			if IsProductError(err, connectivity.ECSCode, ErrorConflict, ErrorRetryable) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw)
+
+	stateConf := BuildStateConf(client, []string{}, []string{}, d.Timeout(schema.TimeoutDelete), 0,
+		ecsService.ImageStateRefreshFunc(d.Id(), []string{}))
+	if _, err = stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// The copied image is in the region of the resource, and the source image is in the source region.
+func resourceAliyunImageCopy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunImageCopyCreate,
+		Read:   resourceAliyunImageCopyRead,
+		Update: resourceAliyunImageCopyUpdate,
+		Delete: resourceAliyunImageDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
+		CustomizeDiff: setTagsAllDiff,
+
+		Schema: map[string]*schema.Schema{
+			"source_image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"encrypted": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateStringLengthInRange(2, 256),
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+func resourceAliyunImageCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	sourceRegionId := d.Get("source_region_id").(string)
+	if sourceRegionId == "" {
+		sourceRegionId = client.RegionId
+	}
+	sourceClient, err := client.ForRegion(sourceRegionId)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := ecs.CreateCopyImageRequest()
+	request.RegionId = sourceRegionId
+	request.ImageId = d.Get("source_image_id").(string)
+	request.DestinationRegionId = client.RegionId
+	request.DestinationImageName = d.Get("name").(string)
+	request.DestinationDescription = d.Get("description").(string)
+	if d.Get("encrypted").(bool) {
+		request.Encrypted = requests.NewBoolean(true)
+		request.KMSKeyId = d.Get("kms_key_id").(string)
+	}
+
+	raw, err := sourceClient.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.CopyImage(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_image_copy", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw)
+	response, _ := raw.(*ecs.CopyImageResponse)
+	d.SetId(response.ImageId)
+	d.Set("source_region_id", sourceRegionId)
+
+	// CopyImage does not return its task, so the copied image is waited until it is available.
+	stateConf := BuildStateConf(client, []string{string(ImageCreating), string(ImageWaiting)}, []string{string(ImageAvailable)},
+		d.Timeout(schema.TimeoutCreate), 5*time.Second,
+		ecsService.ImageStateRefreshFunc(d.Id(), []string{string(ImageCreateFailed), string(ImageUnAvailable)}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAliyunImageCopyUpdate(d, meta)
+}
+
+func resourceAliyunImageCopyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	object, err := ecsService.DescribeImage(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("name", object.ImageName)
+	d.Set("description", object.Description)
+	d.Set("status", object.Status)
+
+	tags, err := ecsService.DescribeTags(d.Id(), TagResourceImage)
+	if err != nil && !NotFoundError(err) {
+		return WrapError(err)
+	}
+	if err := setResourceTags(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
+
+	return nil
+}
+
+func resourceAliyunImageCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	if err := setTags(client, TagResourceImage, d); err != nil {
+		return WrapError(err)
+	}
+
+	if err := modifyImageAttribute(client, d); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAliyunImageCopyRead(d, meta)
+}
@@ -0,0 +1,93 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudImageCopyBasic(t *testing.T) {
+
+	var v ecs.Image
+	resourceId := "alicloud_image_copy.default"
+	rand := acctest.RandInt()
+	name := fmt.Sprintf("tf-testAccImageCopyBasic%d", rand)
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"source_image_id":  CHECKSET,
+		"source_region_id": CHECKSET,
+		"name":             name,
+		"description":      name,
+		"status":           string(ImageAvailable),
+		"tags.%":           "1",
+		"tags.version":     "1.0",
+	})
+
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeImage")
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceImageCopyConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"source_image_id": "${alicloud_image.default.id}",
+					"name":            "${var.name}",
+					"description":     "${var.name}",
+					"tags": map[string]string{
+						"version": "1.0",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_image_id", "source_region_id", "encrypted", "force"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"name":        "${var.name}_update",
+					"description": "${var.name}_update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name":        name + "_update",
+						"description": name + "_update",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceImageCopyConfigDependence(name string) string {
+	return fmt.Sprintf(`%s
+
+resource "alicloud_image" "default" {
+  instance_id = "${alicloud_instance.default.id}"
+  name        = "${var.name}"
+}
+`, resourceImageConfigDependence(name))
+}
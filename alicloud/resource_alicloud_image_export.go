@@ -0,0 +1,131 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// The ID of an image export is the image ID and the ID of its task. The exported files are left in OSS when it is
+// destroyed.
+func resourceAliyunImageExport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliyunImageExportCreate,
+		Read:   resourceAliyunImageExportRead,
+		Delete: resourceAliyunImageExportDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"image_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"oss_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"oss_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"image_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"raw", "vhd", "qcow2"}),
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"task_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAliyunImageExportCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	request := ecs.CreateExportImageRequest()
+	request.RegionId = client.RegionId
+	request.ImageId = d.Get("image_id").(string)
+	request.OSSBucket = d.Get("oss_bucket").(string)
+	request.OSSPrefix = d.Get("oss_prefix").(string)
+	request.ImageFormat = d.Get("image_format").(string)
+	request.RoleName = d.Get("role_name").(string)
+
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ExportImage(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_image_export", request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw)
+	response, _ := raw.(*ecs.ExportImageResponse)
+	d.SetId(fmt.Sprintf("%s%s%s", request.ImageId, COLON_SEPARATED, response.TaskId))
+
+	stateConf := BuildStateConf(client, []string{string(TaskWaiting), string(TaskProcessing)}, []string{string(TaskFinished)},
+		d.Timeout(schema.TimeoutCreate), 5*time.Second,
+		ecsService.TaskStateRefreshFunc(response.TaskId, []string{string(TaskFailed), string(TaskDeleted)}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAliyunImageExportRead(d, meta)
+}
+
+func resourceAliyunImageExportRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	if _, err := ecsService.DescribeImage(parts[0]); err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("image_id", parts[0])
+	d.Set("task_id", parts[1])
+	// The tasks are kept for a while only, so the last observed status is kept in the state after the task is gone.
+	task, err := ecsService.DescribeTask(parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	d.Set("status", task.TaskStatus)
+	return nil
+}
+
+func resourceAliyunImageExportDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
@@ -0,0 +1,85 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestAccAlicloudImageExportBasic(t *testing.T) {
+	resourceId := "alicloud_image_export.default"
+	rand := acctest.RandInt()
+	name := fmt.Sprintf("tf-testacc-image-export-%d", rand)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageExportConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExportExists(resourceId),
+					resource.TestCheckResourceAttr(resourceId, "oss_bucket", name),
+					resource.TestCheckResourceAttr(resourceId, "oss_prefix", "export"),
+					resource.TestCheckResourceAttr(resourceId, "status", string(TaskFinished)),
+					resource.TestCheckResourceAttrSet(resourceId, "task_id"),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oss_bucket", "oss_prefix", "image_format", "role_name"},
+			},
+		},
+	})
+}
+
+func testAccCheckImageExportExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return WrapError(fmt.Errorf("Not found: %s", n))
+		}
+		parts, err := ParseResourceId(rs.Primary.ID, 2)
+		if err != nil {
+			return WrapError(err)
+		}
+		client := testAccProvider.Meta().(*connectivity.AliyunClient)
+		ecsService := EcsService{client}
+		task, err := ecsService.DescribeTask(parts[1])
+		if err != nil {
+			return WrapError(err)
+		}
+		if task.TaskStatus != string(TaskFinished) {
+			return WrapError(fmt.Errorf("the export task %s is %s", task.TaskId, task.TaskStatus))
+		}
+		return nil
+	}
+}
+
+func testAccImageExportConfig(name string) string {
+	return fmt.Sprintf(`%s
+
+resource "alicloud_oss_bucket" "default" {
+  bucket = "${var.name}"
+}
+
+resource "alicloud_image_export" "default" {
+  image_id     = "${alicloud_image.default.id}"
+  oss_bucket   = "${alicloud_oss_bucket.default.id}"
+  oss_prefix   = "export"
+  image_format = "qcow2"
+}
+`, resourceImageCopyConfigDependence(name))
+}
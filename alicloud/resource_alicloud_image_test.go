@@ -0,0 +1,232 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func init() {
+	resource.AddTestSweepers("alicloud_image", &resource.Sweeper{
+		Name: "alicloud_image",
+		F:    testSweepImages,
+	})
+}
+
+func testSweepImages(region string) error {
+	rawClient, err := sharedClientForRegion(region)
+	if err != nil {
+		return WrapError(err)
+	}
+	client := rawClient.(*connectivity.AliyunClient)
+
+	prefixes := []string{
+		"tf-testAcc",
+		"tf_testAcc",
+	}
+
+	var images []ecs.Image
+	request := ecs.CreateDescribeImagesRequest()
+	request.RegionId = client.RegionId
+	request.ImageOwnerAlias = "self"
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeImages(request)
+		})
+		if err != nil {
+			return WrapError(err)
+		}
+		response, _ := raw.(*ecs.DescribeImagesResponse)
+		if len(response.Images.Image) < 1 {
+			break
+		}
+		images = append(images, response.Images.Image...)
+
+		if len(response.Images.Image) < PageSizeLarge {
+			break
+		}
+
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return err
+		} else {
+			request.PageNumber = page
+		}
+	}
+
+	for _, v := range images {
+		name := v.ImageName
+		id := v.ImageId
+		skip := true
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(strings.ToLower(name), strings.ToLower(prefix)) {
+				skip = false
+				break
+			}
+		}
+		if skip {
+			log.Printf("[INFO] Skipping image: %s (%s)", name, id)
+			continue
+		}
+		log.Printf("[INFO] Deleting image: %s (%s)", name, id)
+		req := ecs.CreateDeleteImageRequest()
+		req.ImageId = id
+		req.Force = requests.NewBoolean(true)
+		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DeleteImage(req)
+		})
+		if err != nil {
+			log.Printf("[ERROR] Failed to delete image(%s (%s)): %s", name, id, err)
+		}
+	}
+	return nil
+}
+
+func TestAccAlicloudImageBasic(t *testing.T) {
+
+	var v ecs.Image
+	resourceId := "alicloud_image.default"
+	rand := acctest.RandInt()
+	name := fmt.Sprintf("tf-testAccImageBasic%d", rand)
+	ra := resourceAttrInit(resourceId, map[string]string{
+		"instance_id":           CHECKSET,
+		"name":                  name,
+		"description":           name,
+		"status":                string(ImageAvailable),
+		"disk_device_mapping.#": "1",
+		"tags.%":                "1",
+		"tags.version":          "1.0",
+	})
+
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceImageConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+
+		// module name
+		IDRefreshName: resourceId,
+
+		Providers:    testAccProviders,
+		CheckDestroy: rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_id": "${alicloud_instance.default.id}",
+					"name":        "${var.name}",
+					"description": "${var.name}",
+					"tags": map[string]string{
+						"version": "1.0",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"instance_id", "force"},
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"name":        "${var.name}_update",
+					"description": "${var.name}_update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"name":        name + "_update",
+						"description": name + "_update",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"tags": map[string]string{
+						"version": "1.0",
+						"tag2":    "tag2",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"tags.%":    "2",
+						"tags.tag2": "tag2",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"force": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"force": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceImageConfigDependence(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+data "alicloud_instance_types" "default" {
+  	cpu_core_count    = 1
+	memory_size       = 2
+}
+
+resource "alicloud_vpc" "default" {
+  name = "${var.name}"
+  cidr_block = "192.168.0.0/16"
+}
+
+resource "alicloud_vswitch" "default" {
+  name = "${var.name}"
+  cidr_block = "192.168.0.0/24"
+  availability_zone = "${data.alicloud_instance_types.default.instance_types.0.availability_zones.0}"
+  vpc_id = "${alicloud_vpc.default.id}"
+}
+
+resource "alicloud_security_group" "default" {
+  name = "${var.name}"
+  description = "New security group"
+  vpc_id = "${alicloud_vpc.default.id}"
+}
+
+data "alicloud_images" "default" {
+  owners = "system"
+}
+
+resource "alicloud_instance" "default" {
+  availability_zone = "${data.alicloud_instance_types.default.instance_types.0.availability_zones.0}"
+  instance_name   = "${var.name}"
+  host_name       = "tf-testAcc"
+  image_id        = "${data.alicloud_images.default.images.0.id}"
+  instance_type   = "${data.alicloud_instance_types.default.instance_types.0.id}"
+  security_groups = ["${alicloud_security_group.default.id}"]
+  vswitch_id      = "${alicloud_vswitch.default.id}"
+}
+`, name)
+}
@@ -36,6 +36,8 @@ func resourceAliyunInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -258,8 +260,17 @@ func resourceAliyunInstance() *schema.Resource {
 			},
 
 			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{string(Running), string(Stopped)}),
+			},
+			"stopped_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validateAllowedStringValue([]string{string(KeepCharging), string(StopCharging)}),
+				DiffSuppressFunc: ecsStoppedModeDiffSuppressFunc,
 			},
 
 			"user_data": {
@@ -279,7 +290,6 @@ func resourceAliyunInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 
 			"spot_strategy": {
@@ -312,6 +322,12 @@ func resourceAliyunInstance() *schema.Resource {
 				DiffSuppressFunc: ecsPostPaidDiffSuppressFunc,
 			},
 
+			"stop_instance_before_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"security_enhancement_strategy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -323,6 +339,7 @@ func resourceAliyunInstance() *schema.Resource {
 			},
 
 			"tags":        tagsSchema(),
+			"tags_all":    tagsAllSchema(),
 			"volume_tags": tagsSchemaComputed(),
 		},
 	}
@@ -349,11 +366,15 @@ func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	if d.Get("is_outdated").(bool) == true {
 		request.IoOptimized = "none"
 	}
+	resend := clientTokenWait(client)
 	err = resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.RunInstances(request)
 		})
 		if err != nil {
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
 			if IsExceptedErrors(err, []string{InvalidPrivateIpAddressDuplicated}) {
 				return resource.RetryableError(err)
 			}
@@ -368,7 +389,7 @@ func resourceAliyunInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_instance", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	stateConf := BuildStateConf([]string{"Pending", "Starting", "Stopped"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{"Stopping"}))
+	stateConf := BuildStateConf(client, []string{"Pending", "Starting", "Stopped"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{"Stopping"}))
 
 	if _, err := stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
@@ -419,6 +440,10 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("spot_price_limit", instance.SpotPriceLimit)
 	d.Set("internet_charge_type", instance.InternetChargeType)
 	d.Set("deletion_protection", instance.DeletionProtection)
+	// The stopped mode is "Not-applicable" when the instance is running.
+	if instance.StoppedMode == string(KeepCharging) || instance.StoppedMode == string(StopCharging) {
+		d.Set("stopped_mode", instance.StoppedMode)
+	}
 
 	if len(instance.PublicIpAddress.IpAddress) > 0 {
 		d.Set("public_ip", instance.PublicIpAddress.IpAddress[0])
@@ -490,13 +515,9 @@ func resourceAliyunInstanceRead(d *schema.ResourceData, meta interface{}) error
 		}
 
 	}
-	tags, err := ecsService.DescribeTags(d.Id(), TagResourceInstance)
-	if err != nil && !NotFoundError(err) {
+	if err := setResourceTags(client, d, tagsToMap(instance.Tags.Tag)); err != nil {
 		return WrapError(err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
-	}
 
 	ids, err := ecsService.QueryInstanceAllDisks(d.Id())
 	if err != nil {
@@ -577,6 +598,16 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		return WrapError(err)
 	}
 
+	systemDiskUpdate, err := modifyInstanceSystemDisk(d, meta, run)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	keyPairUpdate, err := modifyInstanceKeyPair(d, meta, run)
+	if err != nil {
+		return WrapError(err)
+	}
+
 	vpcUpdate, err := modifyVpcAttribute(d, meta, run)
 	if err != nil {
 		return WrapError(err)
@@ -591,28 +622,26 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 	if err != nil {
 		return WrapError(err)
 	}
-	if imageUpdate || vpcUpdate || passwordUpdate || typeUpdate {
+	if imageUpdate || systemDiskUpdate || keyPairUpdate || vpcUpdate || passwordUpdate || typeUpdate {
 		run = true
 		log.Printf("[INFO] Need rebooting to make all changes valid.")
 		instance, errDesc := ecsService.DescribeInstance(d.Id())
 		if errDesc != nil {
 			return WrapError(errDesc)
 		}
+		// The instance is left stopped afterwards when it is requested so, and then it is stopped in the requested mode.
+		stoppedMode := ""
+		if d.Get("status").(string) == string(Stopped) {
+			stoppedMode = d.Get("stopped_mode").(string)
+		}
 		if instance.Status == string(Running) {
-			log.Printf("[DEBUG] Stop instance when changing image or password or vpc attribute")
-			stopRequest := ecs.CreateStopInstanceRequest()
-			stopRequest.InstanceId = d.Id()
-			stopRequest.ForceStop = requests.NewBoolean(false)
-			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-				return ecsClient.StopInstance(stopRequest)
-			})
-			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), stopRequest.GetActionName(), AlibabaCloudSdkGoERROR)
+			log.Printf("[DEBUG] Stop instance when changing image, system disk, key pair, password or vpc attribute")
+			if err := stopInstance(d, meta, stoppedMode); err != nil {
+				return WrapError(err)
 			}
-			addDebug(stopRequest.GetActionName(), raw)
 		}
 
-		stateConf := BuildStateConf([]string{"Pending", "Running", "Stopping"}, []string{"Stopped"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{}))
+		stateConf := BuildStateConf(client, []string{"Pending", "Running", "Stopping"}, []string{"Stopped"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{}))
 
 		if _, err = stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
@@ -622,6 +651,19 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 			return WrapError(err)
 		}
 
+		// The system disk and key pair may have been changed online already.
+		if systemDiskUpdate {
+			if _, err := modifyInstanceSystemDisk(d, meta, run); err != nil {
+				return WrapError(err)
+			}
+		}
+
+		if keyPairUpdate {
+			if _, err := modifyInstanceKeyPair(d, meta, run); err != nil {
+				return WrapError(err)
+			}
+		}
+
 		if _, err := modifyVpcAttribute(d, meta, run); err != nil {
 			return WrapError(err)
 		}
@@ -630,42 +672,16 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 			return WrapError(err)
 		}
 
-		log.Printf("[DEBUG] Start instance after changing image or password or vpc attribute")
-		startRequest := ecs.CreateStartInstanceRequest()
-		startRequest.InstanceId = d.Id()
-
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-				return ecsClient.StartInstance(startRequest)
-			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{"IncorrectInstanceStatus"}) {
-					time.Sleep(time.Second)
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
+		if d.Get("status").(string) != string(Stopped) {
+			log.Printf("[DEBUG] Start instance after changing image, system disk, key pair, password or vpc attribute")
+			if err := startInstance(d, meta); err != nil {
+				return WrapError(err)
 			}
-			addDebug(startRequest.GetActionName(), raw)
-			return nil
-		})
-
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), startRequest.GetActionName(), AlibabaCloudSdkGoERROR)
-		}
-
-		// Start instance sometimes costs more than 8 minutes when os type is centos.
-		stateConf = &resource.StateChangeConf{
-			Pending:    []string{"Pending", "Starting", "Stopped"},
-			Target:     []string{"Running"},
-			Refresh:    ecsService.InstanceStateRefreshFunc(d.Id(), []string{}),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      5 * time.Second,
-			MinTimeout: 3 * time.Second,
 		}
+	}
 
-		if _, err = stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
-		}
+	if err := modifyInstanceStatus(d, meta); err != nil {
+		return WrapError(err)
 	}
 
 	if err := modifyInstanceNetworkSpec(d, meta); err != nil {
@@ -680,9 +696,11 @@ func resourceAliyunInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	// Only PrePaid instance can support modifying renewal attribute
-	if d.Get("instance_charge_type").(string) == string(PrePaid) &&
-		(d.HasChange("renewal_status") || d.HasChange("auto_renew_period")) {
+	// Only PrePaid instance can support modifying renewal attribute, and an instance converted to PrePaid takes the
+	// renewal attribute after the conversion unless the conversion is a dry run.
+	dryRun := d.HasChange("instance_charge_type") && d.Get("dry_run").(bool)
+	if d.Get("instance_charge_type").(string) == string(PrePaid) && !dryRun &&
+		(d.HasChange("renewal_status") || d.HasChange("auto_renew_period") || d.HasChange("instance_charge_type")) {
 		status := d.Get("renewal_status").(string)
 		request := ecs.CreateModifyInstanceAutoRenewAttributeRequest()
 		request.InstanceId = d.Id()
@@ -748,7 +766,7 @@ func resourceAliyunInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), deleteRequest.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	stateConf := BuildStateConf([]string{"Pending", "Running", "Stopped", "Stopping"}, []string{}, d.Timeout(schema.TimeoutUpdate), 10*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{}))
+	stateConf := BuildStateConf(client, []string{"Pending", "Running", "Stopped", "Stopping"}, []string{}, d.Timeout(schema.TimeoutUpdate), 10*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{}))
 
 	if _, err = stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
@@ -915,6 +933,105 @@ func buildAliyunInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.Run
 	return request, nil
 }
 
+// modifyInstanceStatus starts or stops the instance to the requested status. A stopped instance is started and
+// stopped again when it is requested to be stopped in another mode.
+func modifyInstanceStatus(d *schema.ResourceData, meta interface{}) error {
+	status := d.Get("status").(string)
+	if status != string(Running) && status != string(Stopped) {
+		return nil
+	}
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	instance, err := ecsService.DescribeInstance(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	switch Status(instance.Status) {
+	case Pending, Starting:
+		if err := ecsService.WaitForEcsInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapError(err)
+		}
+		instance.Status = string(Running)
+	case Stopping:
+		if err := ecsService.WaitForEcsInstance(d.Id(), Stopped, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
+			return WrapError(err)
+		}
+		if instance, err = ecsService.DescribeInstance(d.Id()); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	stoppedMode := d.Get("stopped_mode").(string)
+	if status == string(Running) && instance.Status == string(Stopped) {
+		if err := startInstance(d, meta); err != nil {
+			return WrapError(err)
+		}
+	} else if status == string(Stopped) && instance.Status == string(Running) {
+		if err := stopInstance(d, meta, stoppedMode); err != nil {
+			return WrapError(err)
+		}
+	} else if status == string(Stopped) && stoppedMode != "" && instance.StoppedMode != stoppedMode {
+		if err := startInstance(d, meta); err != nil {
+			return WrapError(err)
+		}
+		if err := stopInstance(d, meta, stoppedMode); err != nil {
+			return WrapError(err)
+		}
+	}
+	d.SetPartial("status")
+	d.SetPartial("stopped_mode")
+	return nil
+}
+
+// stopInstance stops the instance in the stopped mode, which is left to the default of the account when it is empty,
+// and waits until the instance is stopped.
+func stopInstance(d *schema.ResourceData, meta interface{}, stoppedMode string) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	request := ecs.CreateStopInstanceRequest()
+	request.InstanceId = d.Id()
+	request.ForceStop = requests.NewBoolean(false)
+	request.StoppedMode = stoppedMode
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.StopInstance(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw)
+	return WrapError(ecsService.WaitForEcsInstance(d.Id(), Stopped, timeoutSeconds(d, schema.TimeoutUpdate)))
+}
+
+// startInstance starts the instance and waits until it is running.
+func startInstance(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	request := ecs.CreateStartInstanceRequest()
+	request.InstanceId = d.Id()
+	wait := dependencyWait(client, time.Second)
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.StartInstance(request)
+		})
+		if err != nil {
+			if IsExceptedErrors(err, []string{"IncorrectInstanceStatus"}) && wait() {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	// Start instance sometimes costs more than 8 minutes when os type is centos.
+	return WrapError(ecsService.WaitForEcsInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)))
+}
+
 func modifyInstanceChargeType(d *schema.ResourceData, meta interface{}, forceDelete bool) error {
 	if d.IsNewResource() {
 		return nil
@@ -931,20 +1048,21 @@ func modifyInstanceChargeType(d *schema.ResourceData, meta interface{}, forceDel
 		request.InstanceIds = convertListToJsonString(append(make([]interface{}, 0, 1), d.Id()))
 		request.IncludeDataDisks = requests.NewBoolean(d.Get("include_data_disks").(bool))
 		request.AutoPay = requests.NewBoolean(true)
-		request.DryRun = requests.NewBoolean(d.Get("dry_run").(bool))
-		request.ClientToken = fmt.Sprintf("terraform-modify-instance-charge-type-%s", d.Id())
+		request.DryRun = requests.NewBoolean(!forceDelete && d.Get("dry_run").(bool))
+		// Every conversion takes a new token, and the retries of the same conversion resend it.
+		request.ClientToken = buildClientToken(request.GetActionName())
 		if chargeType == string(PrePaid) {
 			request.Period = requests.NewInteger(d.Get("period").(int))
 			request.PeriodUnit = d.Get("period_unit").(string)
 		}
 		request.InstanceChargeType = chargeType
-		if err := resource.Retry(6*time.Minute, func() *resource.RetryError {
+		wait := incrementalWait(client, 10*time.Second, 10*time.Second)
+		if err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceChargeType(request)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{Throttling}) {
-					time.Sleep(10 * time.Second)
+				if IsThrottledError(err) && wait() {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -952,10 +1070,18 @@ func modifyInstanceChargeType(d *schema.ResourceData, meta interface{}, forceDel
 			addDebug(request.GetActionName(), raw)
 			return nil
 		}); err != nil {
+			// The dry run only checks the request, the permission and the balance, and the charge type is not changed.
+			if !forceDelete && d.Get("dry_run").(bool) && IsExceptedError(err, EcsDryRunOperation) {
+				log.Printf("[INFO] Instance %s passed the dry run of changing the charge type to %s.", d.Id(), chargeType)
+				return nil
+			}
+			if IsExceptedErrors(err, EcsOrderPaymentErrors) {
+				return WrapErrorf(err, OrderPaymentErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+			}
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		// Wait for instance charge type has been changed
-		if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			if instance, err := ecsService.DescribeInstance(d.Id()); err != nil {
 				return resource.NonRetryableError(err)
 			} else if instance.InstanceChargeType == chargeType {
@@ -980,20 +1106,22 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 	client := meta.(*connectivity.AliyunClient)
 	ecsService := EcsService{client}
 	update := false
-	if d.HasChange("image_id") || d.HasChange("system_disk_size") {
+	// The system disk can be replaced only when the instance is stopped, and the new system disk takes the new size,
+	// key pair or password at once.
+	if d.HasChange("image_id") {
 		update = true
 		if !run {
 			return update, nil
 		}
-		instance, err := ecsService.DescribeInstance(d.Id())
-		if err != nil {
-			return update, WrapError(err)
-		}
-		keyPairName := instance.KeyPairName
 		request := ecs.CreateReplaceSystemDiskRequest()
 		request.InstanceId = d.Id()
 		request.ImageId = d.Get("image_id").(string)
 		request.SystemDiskSize = requests.NewInteger(d.Get("system_disk_size").(int))
+		if v := d.Get("key_name").(string); v != "" {
+			request.KeyPairName = v
+		} else if v := d.Get("password").(string); v != "" {
+			request.Password = v
+		}
 		request.ClientToken = buildClientToken(request.GetActionName())
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ReplaceSystemDisk(request)
@@ -1003,7 +1131,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 		}
 		addDebug(request.GetActionName(), raw)
 		// Ensure instance's image has been replaced successfully.
-		timeout := DefaultTimeoutMedium
+		timeout := timeoutSeconds(d, schema.TimeoutUpdate)
 		for {
 			instance, errDesc := ecsService.DescribeInstance(d.Id())
 			if errDesc != nil {
@@ -1027,7 +1155,9 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 			if instance.ImageId == d.Get("image_id") && disk.Size == d.Get("system_disk_size").(int) {
 				break
 			}
-			time.Sleep(DefaultIntervalShort * time.Second)
+			if err := sleepContext(client, DefaultIntervalShort*time.Second); err != nil {
+				return update, WrapError(err)
+			}
 
 			timeout = timeout - DefaultIntervalShort
 			if timeout <= 0 {
@@ -1037,15 +1167,82 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 
 		d.SetPartial("system_disk_size")
 		d.SetPartial("image_id")
+		d.SetPartial("key_name")
+	}
+	return update, nil
+}
 
-		// After updating image, it need to re-attach key pair
-		if keyPairName != "" {
-			if err := ecsService.AttachKeyPair(keyPairName, []interface{}{d.Id()}); err != nil {
-				return update, WrapError(err)
-			}
+// modifyInstanceSystemDisk resizes the system disk online when it is not replaced. It needs stopping the instance
+// only when the disk can not be resized online or when stop_instance_before_update is set, and then the disk is
+// resized offline in the run pass.
+func modifyInstanceSystemDisk(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+	if d.IsNewResource() || d.HasChange("image_id") || !d.HasChange("system_disk_size") {
+		return false, nil
+	}
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	resizeType := DiskResizeTypeOnline
+	if run {
+		resizeType = DiskResizeTypeOffline
+	} else if d.Get("stop_instance_before_update").(bool) {
+		return true, nil
+	}
+
+	disk, err := ecsService.QueryInstanceSystemDisk(d.Id())
+	if err != nil {
+		return false, WrapError(err)
+	}
+	size := d.Get("system_disk_size").(int)
+	request := ecs.CreateResizeDiskRequest()
+	request.DiskId = disk.DiskId
+	request.NewSize = requests.NewInteger(size)
+	request.Type = string(resizeType)
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ResizeDisk(request)
+	})
+	if err != nil {
+		if !run && IsExceptedErrors(err, DiskNotSupportOnlineChangeErrors) {
+			log.Printf("[INFO] The system disk %s of instance %s can not be resized online: %#v", disk.DiskId, d.Id(), err)
+			return true, nil
 		}
+		return false, WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return update, nil
+	addDebug(request.GetActionName(), raw)
+
+	if err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		disk, err := ecsService.QueryInstanceSystemDisk(d.Id())
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if disk.Size != size {
+			return resource.RetryableError(Error("Waitting for the system disk of instance %s to be resized to %d GiB.", d.Id(), size))
+		}
+		return nil
+	}); err != nil {
+		return false, WrapError(err)
+	}
+	d.SetPartial("system_disk_size")
+	return false, nil
+}
+
+// modifyInstanceKeyPair attaches the new key pair when the system disk is not replaced. The key pair takes effect
+// after restarting the instance, so it is attached in the run pass when stop_instance_before_update is set.
+func modifyInstanceKeyPair(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+	if d.IsNewResource() || d.HasChange("image_id") || !d.HasChange("key_name") {
+		return false, nil
+	}
+	if !run && d.Get("stop_instance_before_update").(bool) {
+		return true, nil
+	}
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+
+	if err := ecsService.AttachKeyPair(d.Get("key_name").(string), []interface{}{d.Id()}); err != nil {
+		return false, WrapError(err)
+	}
+	d.SetPartial("key_name")
+	return false, nil
 }
 
 func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, error) {
@@ -1103,7 +1300,9 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 			})
 			if err != nil {
 				if IsExceptedErrors(err, []string{"InvalidChargeType.ValueNotSupported"}) {
-					time.Sleep(time.Minute)
+					if err := sleepContext(client, time.Minute); err != nil {
+						return resource.NonRetryableError(err)
+					}
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -1157,13 +1356,13 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 
 	if update {
 		client := meta.(*connectivity.AliyunClient)
+		wait := dependencyWait(client, 1*time.Second)
 		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceVpcAttribute(request)
 			})
 			if err != nil {
-				if IsExceptedErrors(err, []string{"OperationConflict"}) {
-					time.Sleep(1 * time.Second)
+				if IsExceptedErrors(err, []string{"OperationConflict"}) && wait() {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -1212,13 +1411,13 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 			request.InstanceId = d.Id()
 			request.InstanceType = d.Get("instance_type").(string)
 
+			wait := incrementalWait(client, 5*time.Second, 5*time.Second)
 			err = resource.Retry(6*time.Minute, func() *resource.RetryError {
 				raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 					return ecsClient.ModifyPrepayInstanceSpec(request)
 				})
 				if err != nil {
-					if IsExceptedError(err, EcsThrottling) {
-						time.Sleep(5 * time.Second)
+					if IsThrottledError(err) && wait() {
 						return resource.RetryableError(err)
 					}
 					return resource.NonRetryableError(err)
@@ -1236,14 +1435,14 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 			request.InstanceType = d.Get("instance_type").(string)
 			request.ClientToken = buildClientToken(request.GetActionName())
 
+			wait := incrementalWait(client, 10*time.Second, 10*time.Second)
 			err = resource.Retry(6*time.Minute, func() *resource.RetryError {
 				args := *request
 				raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 					return ecsClient.ModifyInstanceSpec(&args)
 				})
 				if err != nil {
-					if IsExceptedError(err, EcsThrottling) {
-						time.Sleep(10 * time.Second)
+					if IsThrottledError(err) && wait() {
 						return resource.RetryableError(err)
 					}
 					return resource.NonRetryableError(err)
@@ -1260,7 +1459,7 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 		}
 
 		// Ensure instance's type has been replaced successfully.
-		timeout := DefaultTimeoutMedium
+		timeout := timeoutSeconds(d, schema.TimeoutUpdate)
 		for {
 			instance, err := ecsService.DescribeInstance(d.Id())
 
@@ -1277,7 +1476,9 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 				return update, WrapErrorf(err, WaitTimeoutMsg, d.Id(), GetFunc(1), timeout, instance.InstanceType, d.Get("instance_type"), ProviderERROR)
 			}
 
-			time.Sleep(DefaultIntervalShort * time.Second)
+			if err := sleepContext(client, DefaultIntervalShort*time.Second); err != nil {
+				return update, WrapError(err)
+			}
 		}
 		d.SetPartial("instance_type")
 	}
@@ -1294,6 +1495,12 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 	request := ecs.CreateModifyInstanceNetworkSpecRequest()
 	request.InstanceId = d.Id()
 	request.ClientToken = buildClientToken(request.GetActionName())
+	// The spec is changed before the charge type, and the order of a PrePaid instance is paid automatically.
+	chargeType, _ := d.GetChange("instance_charge_type")
+	prePaid := chargeType.(string) == string(PrePaid)
+	if prePaid {
+		request.AutoPay = requests.NewBoolean(true)
+	}
 
 	if d.HasChange("internet_charge_type") {
 		request.NetworkChargeType = d.Get("internet_charge_type").(string)
@@ -1306,6 +1513,10 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 		if o.(int) <= 0 && n.(int) > 0 {
 			allocate = true
 		}
+		if prePaid && n.(int) < o.(int) && d.Get("internet_charge_type").(string) == string(PayByBandwidth) {
+			return WrapError(Error("The 'PrePaid' instance %s can not narrow its internet_max_bandwidth_out from %d to %d when its internet_charge_type is %s.",
+				d.Id(), o.(int), n.(int), PayByBandwidth))
+		}
 		request.InternetMaxBandwidthOut = requests.NewInteger(n.(int))
 		update = true
 		d.SetPartial("internet_max_bandwidth_out")
@@ -1320,13 +1531,13 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 	//An instance that was successfully modified once cannot be modified again within 5 minutes.
 	client := meta.(*connectivity.AliyunClient)
 	if update {
+		wait := incrementalWait(client, 10*time.Second, 10*time.Second)
 		if err := resource.Retry(6*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceNetworkSpec(request)
 			})
 			if err != nil {
-				if IsExceptedError(err, EcsThrottling) {
-					time.Sleep(10 * time.Second)
+				if IsThrottledError(err) && wait() {
 					return resource.RetryableError(err)
 				}
 				if IsExceptedError(err, EcsInternalError) {
@@ -1337,11 +1548,14 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 			addDebug(request.GetActionName(), raw)
 			return nil
 		}); err != nil {
+			if IsExceptedErrors(err, EcsOrderPaymentErrors) {
+				return WrapErrorf(err, OrderPaymentErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+			}
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		ecsService := EcsService{client: client}
 
-		deadline := time.Now().Add(DefaultTimeout * time.Second)
+		deadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
 		for {
 			instance, err := ecsService.DescribeInstance(d.Id())
 			if err != nil {
@@ -1360,7 +1574,9 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 					d.Get("internet_charge_type").(string), instance.InternetChargeType, d.Get("internet_max_bandwidth_out").(int),
 					instance.InternetMaxBandwidthOut, d.Get("internet_max_bandwidth_in").(int), instance.InternetMaxBandwidthIn))
 			}
-			time.Sleep(1 * time.Second)
+			if err := sleepContext(client, 1*time.Second); err != nil {
+				return WrapError(err)
+			}
 		}
 
 		if allocate {
@@ -298,6 +298,19 @@ func TestAccAlicloudInstanceBasic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"system_disk_size":            "60",
+					"stop_instance_before_update": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"system_disk_size":            "60",
+						"stop_instance_before_update": "true",
+						"status":                      "Running",
+					}),
+				),
+			},
 			// private_ip cannot be set separately from vpc
 			/*{
 				Config: testAccConfig(map[string]interface{}{
@@ -623,6 +636,40 @@ func TestAccAlicloudInstanceVpc(t *testing.T) {
 					}),
 				),
 			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"status":       "Stopped",
+					"stopped_mode": "StopCharging",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"status":       "Stopped",
+						"stopped_mode": "StopCharging",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_type": "${data.alicloud_instance_types.default.instance_types.1.id}",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_type": CHECKSET,
+						"status":        "Stopped",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"status":       "Running",
+					"stopped_mode": REMOVEKEY,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"status": "Running",
+					}),
+				),
+			},
 			{
 				Config: testAccConfig(map[string]interface{}{
 					"instance_type":              "${data.alicloud_instance_types.default.instance_types.0.id}",
@@ -1243,6 +1290,111 @@ func TestAccAlicloudInstanceTypeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAlicloudInstanceChargeTypeConversion(t *testing.T) {
+	var v ecs.Instance
+
+	resourceId := "alicloud_instance.default"
+	ra := resourceAttrInit(resourceId, testAccInstanceCheckMap)
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+
+	rand := acctest.RandIntRange(1000, 9999)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	name := fmt.Sprintf("tf-testAccEcsInstanceConfigChargeType%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceInstanceTypeConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"image_id":                   "${data.alicloud_images.default.images.0.id}",
+					"instance_type":              "${data.alicloud_instance_types.new1.instance_types.0.id}",
+					"instance_name":              "${var.name}",
+					"security_groups":            []string{"${alicloud_security_group.default.id}"},
+					"vswitch_id":                 "${alicloud_vswitch.default.id}",
+					"internet_charge_type":       "PayByBandwidth",
+					"internet_max_bandwidth_out": "5",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"user_data":                     REMOVEKEY,
+						"security_enhancement_strategy": REMOVEKEY,
+						"public_ip":                     CHECKSET,
+						"internet_charge_type":          "PayByBandwidth",
+						"internet_max_bandwidth_in":     CHECKSET,
+						"internet_max_bandwidth_out":    "5",
+					}),
+				),
+			},
+			{
+				// The dry run does not change the charge type, so the plan is still not empty.
+				Config: testAccConfig(map[string]interface{}{
+					"instance_charge_type": "PrePaid",
+					"period_unit":          "Week",
+					"dry_run":              "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_charge_type": "PostPaid",
+					}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_charge_type": "PrePaid",
+					"period_unit":          "Week",
+					"dry_run":              "false",
+					"include_data_disks":   "false",
+					"renewal_status":       "AutoRenewal",
+					"auto_renew_period":    "1",
+					"force_delete":         "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_charge_type": "PrePaid",
+						"period":               "1",
+						"period_unit":          "Week",
+						"dry_run":              "false",
+						"include_data_disks":   "false",
+						"renewal_status":       "AutoRenewal",
+						"auto_renew_period":    "1",
+						"force_delete":         "true",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"internet_max_bandwidth_out": "10",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"internet_max_bandwidth_out": "10",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_charge_type": "PostPaid",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_charge_type": "PostPaid",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAlicloudInstanceSpotInstanceLimit(t *testing.T) {
 	var v ecs.Instance
 
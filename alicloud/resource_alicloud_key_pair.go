@@ -23,6 +23,10 @@ func resourceAlicloudKeyPair() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_name": {
 				Type:          schema.TypeString,
@@ -139,7 +143,7 @@ func resourceAlicloudKeyPairDelete(d *schema.ResourceData, meta interface{}) err
 			return ecsClient.DeleteKeyPairs(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, KeyPairNotFound) {
+			if IsProductError(err, connectivity.ECSCode, ErrorNotFound) {
 				return nil
 			}
 			return resource.RetryableError(err)
@@ -150,5 +154,5 @@ func resourceAlicloudKeyPairDelete(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ecsService.WaitForKeyPair(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(ecsService.WaitForKeyPair(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
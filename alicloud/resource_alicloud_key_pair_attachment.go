@@ -21,6 +21,10 @@ func resourceAlicloudKeyPairAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_name": {
 				Type:         schema.TypeString,
@@ -85,7 +89,7 @@ func resourceAlicloudKeyPairAttachmentCreate(d *schema.ResourceData, meta interf
 			addDebug(request.GetActionName(), raw)
 		}
 		for _, id := range newIds {
-			if err := ecsService.WaitForEcsInstance(id, Running, DefaultLongTimeout); err != nil {
+			if err := ecsService.WaitForEcsInstance(id, Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
@@ -19,6 +20,11 @@ func resourceAlicloudKmsKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -57,6 +63,8 @@ func resourceAlicloudKmsKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -102,16 +110,24 @@ func resourceAlicloudKmsKeyRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("deletion_window_in_days", d.Get("deletion_window_in_days").(int))
 	d.Set("arn", object.KeyMetadata.Arn)
 
-	return nil
+	tags, err := kmsService.DescribeKmsKeyTags(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	return WrapError(setResourceTags(client, d, tags))
 }
 
 func resourceAlicloudKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
+	kmsService := &KmsService{client: client}
 	d.Partial(true)
 
+	if err := kmsService.SetKmsKeyTags(d); err != nil {
+		return WrapError(err)
+	}
+
 	if d.HasChange("is_enabled") {
-		kmsService := &KmsService{client: client}
 		key, err := kmsService.DescribeKmsKey(d.Id())
 		if err != nil {
 			return WrapError(err)
@@ -161,5 +177,5 @@ func resourceAlicloudKmsKeyDelete(d *schema.ResourceData, meta interface{}) erro
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(kmsService.WaitForKmsKey(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(kmsService.WaitForKmsKey(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -25,6 +25,13 @@ func resourceAlicloudKVStoreInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -145,6 +152,9 @@ func resourceAlicloudKVStoreInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -158,9 +168,12 @@ func resourceAlicloudKVStoreInstanceCreate(d *schema.ResourceData, meta interfac
 		return WrapError(err)
 	}
 
-	raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
-		return rkvClient.CreateInstance(request)
-	})
+	// KVStore instance names are not unique and there is no token filter.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+			return rkvClient.CreateInstance(request)
+		})
+	}, nil)
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_kvstore_instance", request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -170,7 +183,7 @@ func resourceAlicloudKVStoreInstanceCreate(d *schema.ResourceData, meta interfac
 	d.SetId(response.InstanceId)
 
 	// wait instance status change from Creating to Normal
-	if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+	if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -182,6 +195,11 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 	kvstoreService := KvstoreService{client}
 	d.Partial(true)
 
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, KVStoreTagProduct, TagResourceKVStoreInstance); err != nil {
+		return WrapError(err)
+	}
+
 	if d.HasChange("parameters") {
 		config := make(map[string]interface{})
 		documented := d.Get("parameters").(*schema.Set).List()
@@ -192,7 +210,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 				config[key] = value
 			}
 			cfg, _ := json.Marshal(config)
-			if err := kvstoreService.ModifyInstanceConfig(d.Id(), string(cfg)); err != nil {
+			if err := kvstoreService.ModifyInstanceConfig(d.Id(), string(cfg), timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -202,7 +220,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	if d.HasChange("security_ips") {
 		// wait instance status is Normal before modifying
-		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		request := r_kvstore.CreateModifySecurityIpsRequest()
@@ -222,7 +240,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 		addDebug(request.GetActionName(), raw)
 		d.SetPartial("security_ips")
 		// wait instance status is Normal after modifying
-		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -233,7 +251,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 			instanceType := d.Get("instance_type").(string)
 			if string(KVStoreRedis) == instanceType {
 				// wait instance status is Normal before modifying
-				if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+				if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 					return WrapError(err)
 				}
 
@@ -251,7 +269,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 				d.SetPartial("vpc_auth_mode")
 
 				// The auth mode take some time to be effective, so wait to ensure the state !
-				if err := kvstoreService.WaitForKVstoreInstanceVpcAuthMode(d.Id(), d.Get("vpc_auth_mode").(string), DefaultLongTimeout); err != nil {
+				if err := kvstoreService.WaitForKVstoreInstanceVpcAuthMode(d.Id(), d.Get("vpc_auth_mode").(string), timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 					return WrapError(err)
 				}
 			}
@@ -274,7 +292,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 			}
 			addDebug(prePaidRequest.GetActionName(), raw)
 			// wait instance status is Normal after modifying
-			if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+			if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 				return WrapError(err)
 			}
 			d.SetPartial("instance_charge_type")
@@ -312,7 +330,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	if d.HasChange("instance_class") {
 		// wait instance status is Normal before modifying
-		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 
@@ -321,13 +339,13 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 		request.InstanceClass = d.Get("instance_class").(string)
 		request.EffectiveTime = "Immediately"
 
+		wait := dependencyWait(client, 5*time.Second)
 		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 				return rkvClient.ModifyInstanceSpec(request)
 			})
 			if err != nil {
-				if IsExceptedError(err, "MissingRedisUsedmemoryUnsupportPerfItem") {
-					time.Sleep(time.Duration(5) * time.Second)
+				if IsExceptedError(err, "MissingRedisUsedmemoryUnsupportPerfItem") && wait() {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -339,7 +357,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		// wait instance status is Normal after modifying
-		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		// There needs more time to sync instance class update
@@ -375,7 +393,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	if update {
 		// wait instance status is Normal before modifying
-		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
@@ -386,7 +404,7 @@ func resourceAlicloudKVStoreInstanceUpdate(d *schema.ResourceData, meta interfac
 		}
 		addDebug(request.GetActionName(), raw)
 		// wait instance status is Normal after modifying
-		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, DefaultLongTimeout); err != nil {
+		if err := kvstoreService.WaitForKVstoreInstance(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		d.SetPartial("instance_name")
@@ -445,7 +463,12 @@ func resourceAlicloudKVStoreInstanceRead(d *schema.ResourceData, meta interface{
 		return WrapError(err)
 	}
 
-	return nil
+	tagService := TagService{client}
+	tags, err := tagService.DescribeTags(KVStoreTagProduct, TagResourceKVStoreInstance, d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	return WrapError(setResourceTags(client, d, tags))
 }
 
 func resourceAlicloudKVStoreInstanceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -470,14 +493,14 @@ func resourceAlicloudKVStoreInstanceDelete(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		if !IsExceptedError(err, InvalidKVStoreInstanceIdNotFound) {
+		if !IsProductError(err, connectivity.KVSTORECode, ErrorNotFound) {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 	}
 
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(kvstoreService.WaitForKVstoreInstance(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(kvstoreService.WaitForKVstoreInstance(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func buildKVStoreCreateRequest(d *schema.ResourceData, meta interface{}) (*r_kvstore.CreateInstanceRequest, error) {
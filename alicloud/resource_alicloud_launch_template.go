@@ -3,6 +3,7 @@ package alicloud
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -20,6 +21,10 @@ func resourceAliyunLaunchTemplate() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -483,7 +488,7 @@ func resourceAliyunLaunchTemplateDelete(d *schema.ResourceData, meta interface{}
 	}
 	addDebug(request.GetAcceptFormat(), raw)
 	ecsService := EcsService{client}
-	if err := ecsService.WaitForLaunchTemplate(d.Id(), Deleted, DefaultTimeout); err != nil {
+	if err := ecsService.WaitForLaunchTemplate(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunLaunchTemplateRead(d, meta)
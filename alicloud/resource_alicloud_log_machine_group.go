@@ -20,6 +20,10 @@ func resourceAlicloudLogMachineGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Type:     schema.TypeString,
@@ -57,6 +61,7 @@ func resourceAlicloudLogMachineGroup() *schema.Resource {
 func resourceAlicloudLogMachineGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
+	wait := incrementalWait(client, 5*time.Second, 5*time.Second)
 	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			return nil, slsClient.CreateMachineGroup(d.Get("project").(string), &sls.MachineGroup{
@@ -69,8 +74,7 @@ func resourceAlicloudLogMachineGroupCreate(d *schema.ResourceData, meta interfac
 			})
 		})
 		if err != nil {
-			if IsExceptedError(err, LogClientTimeout) {
-				time.Sleep(5 * time.Second)
+			if IsExceptedError(err, LogClientTimeout) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -120,6 +124,7 @@ func resourceAlicloudLogMachineGroupUpdate(d *schema.ResourceData, meta interfac
 
 		client := meta.(*connectivity.AliyunClient)
 
+		wait := incrementalWait(client, 5*time.Second, 5*time.Second)
 		if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 				return nil, slsClient.UpdateMachineGroup(parts[0], &sls.MachineGroup{
@@ -132,8 +137,7 @@ func resourceAlicloudLogMachineGroupUpdate(d *schema.ResourceData, meta interfac
 				})
 			})
 			if err != nil {
-				if IsExceptedError(err, LogClientTimeout) {
-					time.Sleep(5 * time.Second)
+				if IsExceptedError(err, LogClientTimeout) && wait() {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -156,13 +160,13 @@ func resourceAlicloudLogMachineGroupDelete(d *schema.ResourceData, meta interfac
 		return WrapError(err)
 	}
 
+	wait := incrementalWait(client, 5*time.Second, 5*time.Second)
 	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			return nil, slsClient.DeleteMachineGroup(parts[0], parts[1])
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{LogClientTimeout}) {
-				time.Sleep(5 * time.Second)
+			if IsExceptedErrors(err, []string{LogClientTimeout}) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -173,5 +177,5 @@ func resourceAlicloudLogMachineGroupDelete(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_log_store", "ListShards", AliyunLogGoSdkERROR)
 	}
-	return WrapError(logService.WaitForLogMachineGroup(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(logService.WaitForLogMachineGroup(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -20,6 +20,10 @@ func resourceAlicloudLogProject() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -37,13 +41,13 @@ func resourceAlicloudLogProject() *schema.Resource {
 func resourceAlicloudLogProjectCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 
+	wait := incrementalWait(client, 5*time.Second, 5*time.Second)
 	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			return slsClient.CreateProject(d.Get("name").(string), d.Get("description").(string))
 		})
 		if err != nil {
-			if IsExceptedError(err, LogClientTimeout) {
-				time.Sleep(5 * time.Second)
+			if IsExceptedError(err, LogClientTimeout) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -110,10 +114,10 @@ func resourceAlicloudLogProjectDelete(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{ProjectNotExist}) {
+		if IsProductError(err, connectivity.LOGCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteProject", AliyunLogGoSdkERROR)
 	}
-	return WrapError(logService.WaitForLogProject(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(logService.WaitForLogProject(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -21,6 +21,10 @@ func resourceAlicloudLogStore() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Type:     schema.TypeString,
@@ -260,11 +264,11 @@ func resourceAlicloudLogStoreDelete(d *schema.ResourceData, meta interface{}) er
 	}
 	err = project.DeleteLogStore(parts[1])
 	if err != nil {
-		if IsExceptedErrors(err, []string{LogStoreNotExist}) {
+		if IsProductError(err, connectivity.LOGCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteLogStore", AliyunLogGoSdkERROR)
 	}
 	addDebug("DeleteLogStore", nil)
-	return WrapError(logService.WaitForLogStore(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(logService.WaitForLogStore(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -120,14 +120,14 @@ func resourceAlicloudLogStoreIndexCreate(d *schema.ResourceData, meta interface{
 		return WrapError(err)
 	}
 
+	wait := incrementalWait(client, 5*time.Second, 5*time.Second)
 	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
 		raw, err := store.GetIndex()
 		if err != nil {
-			if IsExceptedError(err, LogClientTimeout) {
-				time.Sleep(5 * time.Second)
+			if IsExceptedError(err, LogClientTimeout) && wait() {
 				return resource.RetryableError(WrapErrorf(err, DefaultErrorMsg, "alicloud_log_store", "GetIndex", AliyunLogGoSdkERROR))
 			}
-			if !IsExceptedErrors(err, []string{IndexConfigNotExist}) {
+			if !IsProductError(err, connectivity.LOGCode, ErrorNotFound) {
 				return resource.NonRetryableError(WrapErrorf(err, DefaultErrorMsg, "alicloud_log_store", "GetIndex", AliyunLogGoSdkERROR))
 			}
 		}
@@ -245,13 +245,13 @@ func resourceAlicloudLogStoreIndexUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	if update {
+		wait := incrementalWait(client, 5*time.Second, 5*time.Second)
 		if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 				return nil, slsClient.UpdateIndex(parts[0], parts[1], *index)
 			})
 			if err != nil {
-				if IsExceptedError(err, LogClientTimeout) {
-					time.Sleep(5 * time.Second)
+				if IsExceptedError(err, LogClientTimeout) && wait() {
 					return resource.RetryableError(err)
 				}
 				return resource.NonRetryableError(err)
@@ -282,13 +282,13 @@ func resourceAlicloudLogStoreIndexDelete(d *schema.ResourceData, meta interface{
 		return WrapError(err)
 	}
 
+	wait := incrementalWait(client, 5*time.Second, 5*time.Second)
 	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			return nil, slsClient.DeleteIndex(parts[0], parts[1])
 		})
 		if err != nil {
-			if IsExceptedError(err, LogClientTimeout) {
-				time.Sleep(5 * time.Second)
+			if IsExceptedError(err, LogClientTimeout) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
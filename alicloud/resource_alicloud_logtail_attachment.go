@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"time"
 
 	sls "github.com/aliyun/aliyun-log-go-sdk"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -16,6 +17,11 @@ func resourceAlicloudLogtailAttachment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"project": {
 				Type:     schema.TypeString,
@@ -89,6 +95,6 @@ func resourceAlicloudLogtailAttachmentDelete(d *schema.ResourceData, meta interf
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "RemoveConfigFromMachineGroup", AliyunLogGoSdkERROR)
 	}
 	addDebug("RemoveConfigFromMachineGroup", raw)
-	return WrapError(logService.WaitForLogtailAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(logService.WaitForLogtailAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 
 }
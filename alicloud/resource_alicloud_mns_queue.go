@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"github.com/dxh031/ali_mns"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
@@ -16,6 +18,10 @@ func resourceAlicloudMNSQueue() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -165,5 +171,5 @@ func resourceAlicloudMNSQueueDelete(d *schema.ResourceData, meta interface{}) er
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteQueue", AliMnsERROR)
 	}
 	addDebug("DeleteQueue", raw)
-	return WrapError(mnsService.WaitForMnsQueue(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(mnsService.WaitForMnsQueue(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"github.com/dxh031/ali_mns"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
@@ -15,6 +17,11 @@ func resourceAlicloudMNSTopic() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -112,5 +119,5 @@ func resourceAlicloudMNSTopicDelete(d *schema.ResourceData, meta interface{}) er
 	}
 	addDebug("DeleteTopic", raw)
 
-	return WrapError(mnsService.WaitForMnsTopic(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(mnsService.WaitForMnsTopic(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/dxh031/ali_mns"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,6 +18,11 @@ func resourceAlicloudMNSSubscription() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"topic_name": {
 				Type:         schema.TypeString,
@@ -157,5 +163,5 @@ func resourceAlicloudMNSSubscriptionDelete(d *schema.ResourceData, meta interfac
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "Unsubscribe", AliMnsERROR)
 	}
 	addDebug("Unsubscribe", raw)
-	return WrapError(mnsService.WaitForMnsTopicSubscription(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(mnsService.WaitForMnsTopicSubscription(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -22,6 +22,7 @@ func resourceAlicloudMongoDBInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -114,6 +115,8 @@ func resourceAlicloudMongoDBInstance() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -185,9 +188,12 @@ func resourceAlicloudMongoDBInstanceCreate(d *schema.ResourceData, meta interfac
 		return WrapError(err)
 	}
 
-	raw, err := client.WithDdsClient(func(client *dds.Client) (interface{}, error) {
-		return client.CreateDBInstance(request)
-	})
+	// MongoDB instance names are not unique.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithDdsClient(func(client *dds.Client) (interface{}, error) {
+			return client.CreateDBInstance(request)
+		})
+	}, nil)
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_mongodb_instance", request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -198,7 +204,7 @@ func resourceAlicloudMongoDBInstanceCreate(d *schema.ResourceData, meta interfac
 
 	d.SetId(response.DBInstanceId)
 
-	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, ddsService.RdsMongodbDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
+	stateConf := BuildStateConf(client, []string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, ddsService.RdsMongodbDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 	if _, err := stateConf.WaitForState(); err != nil {
 		return WrapError(err)
 	}
@@ -246,7 +252,12 @@ func resourceAlicloudMongoDBInstanceRead(d *schema.ResourceData, meta interface{
 		d.Set("replication_factor", replication_factor)
 	}
 
-	return nil
+	tagService := TagService{client}
+	tags, err := tagService.DescribeTags(DdsTagProduct, TagResourceMongoDBInstance, d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	return WrapError(setResourceTags(client, d, tags))
 }
 
 func resourceAlicloudMongoDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -255,6 +266,11 @@ func resourceAlicloudMongoDBInstanceUpdate(d *schema.ResourceData, meta interfac
 
 	d.Partial(true)
 
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, DdsTagProduct, TagResourceMongoDBInstance); err != nil {
+		return WrapError(err)
+	}
+
 	if d.HasChange("backup_time") || d.HasChange("backup_period") {
 		if err := ddsService.MotifyMongoDBBackupPolicy(d); err != nil {
 			return WrapError(err)
@@ -292,7 +308,7 @@ func resourceAlicloudMongoDBInstanceUpdate(d *schema.ResourceData, meta interfac
 			ipstr = LOCAL_HOST_IP
 		}
 
-		if err := ddsService.ModifyMongoDBSecurityIps(d.Id(), ipstr); err != nil {
+		if err := ddsService.ModifyMongoDBSecurityIps(d.Id(), ipstr, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		d.SetPartial("security_ip_list")
@@ -318,7 +334,7 @@ func resourceAlicloudMongoDBInstanceUpdate(d *schema.ResourceData, meta interfac
 		request.ReplicationFactor = strconv.Itoa(d.Get("replication_factor").(int))
 
 		// wait instance status is running before modifying
-		stateConf := BuildStateConf([]string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 1*time.Minute, ddsService.RdsMongodbDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
+		stateConf := BuildStateConf(client, []string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 1*time.Minute, ddsService.RdsMongodbDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapError(err)
 		}
@@ -372,12 +388,12 @@ func resourceAlicloudMongoDBInstanceDelete(d *schema.ResourceData, meta interfac
 	})
 
 	if err != nil {
-		if IsExceptedErrors(err, []string{"InvalidDBInstanceId.NotFound"}) {
+		if IsProductError(err, connectivity.DDSCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	stateConf := BuildStateConf([]string{"Creating", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 1*time.Minute, ddsService.RdsMongodbDBInstanceStateRefreshFunc(d.Id(), []string{}))
+	stateConf := BuildStateConf(client, []string{"Creating", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 1*time.Minute, ddsService.RdsMongodbDBInstanceStateRefreshFunc(d.Id(), []string{}))
 	_, err = stateConf.WaitForState()
 	return WrapError(err)
 }
@@ -22,6 +22,12 @@ func resourceAlicloudMongoDBShardingInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultLongTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"engine_version": {
 				Type:     schema.TypeString,
@@ -233,9 +239,12 @@ func resourceAlicloudMongoDBShardingInstanceCreate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 
-	raw, err := client.WithDdsClient(func(client *dds.Client) (interface{}, error) {
-		return client.CreateShardingDBInstance(request)
-	})
+	// Sharding instance names are not unique either.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithDdsClient(func(client *dds.Client) (interface{}, error) {
+			return client.CreateShardingDBInstance(request)
+		})
+	}, nil)
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_mongodb_sharding_instance", request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -246,7 +255,7 @@ func resourceAlicloudMongoDBShardingInstanceCreate(d *schema.ResourceData, meta
 
 	d.SetId(response.DBInstanceId)
 
-	if err := ddsService.WaitForMongoDBInstance(d.Id(), Running, DefaultLongTimeout); err != nil {
+	if err := ddsService.WaitForMongoDBInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -340,7 +349,7 @@ func resourceAlicloudMongoDBShardingInstanceUpdate(d *schema.ResourceData, meta
 
 	if d.HasChange("shard_list") {
 		state, diff := d.GetChange("shard_list")
-		err := ddsService.ModifyMongodbShardingInstanceNode(d.Id(), MongoDBShardingNodeShard, state.([]interface{}), diff.([]interface{}))
+		err := ddsService.ModifyMongodbShardingInstanceNode(d.Id(), MongoDBShardingNodeShard, state.([]interface{}), diff.([]interface{}), timeoutSeconds(d, schema.TimeoutUpdate))
 		if err != nil {
 			return WrapError(err)
 		}
@@ -349,7 +358,7 @@ func resourceAlicloudMongoDBShardingInstanceUpdate(d *schema.ResourceData, meta
 
 	if d.HasChange("mongo_list") {
 		state, diff := d.GetChange("mongo_list")
-		err := ddsService.ModifyMongodbShardingInstanceNode(d.Id(), MongoDBShardingNodeMongos, state.([]interface{}), diff.([]interface{}))
+		err := ddsService.ModifyMongodbShardingInstanceNode(d.Id(), MongoDBShardingNodeMongos, state.([]interface{}), diff.([]interface{}), timeoutSeconds(d, schema.TimeoutUpdate))
 		if err != nil {
 			return WrapError(err)
 		}
@@ -388,7 +397,7 @@ func resourceAlicloudMongoDBShardingInstanceUpdate(d *schema.ResourceData, meta
 			ipstr = LOCAL_HOST_IP
 		}
 
-		if err := ddsService.ModifyMongoDBSecurityIps(d.Id(), ipstr); err != nil {
+		if err := ddsService.ModifyMongoDBSecurityIps(d.Id(), ipstr, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		d.SetPartial("security_ip_list")
@@ -422,5 +431,5 @@ func resourceAlicloudMongoDBShardingInstanceDelete(d *schema.ResourceData, meta
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ddsService.WaitForMongoDBInstance(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ddsService.WaitForMongoDBInstance(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +19,11 @@ func resourceAlicloudNasMountTarget() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"file_system_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -70,7 +76,7 @@ func resourceAlicloudNasMountTargetCreate(d *schema.ResourceData, meta interface
 	}
 	response, _ := raw.(*nas.CreateMountTargetResponse)
 	d.SetId(response.MountTargetDomain)
-	err = nasService.WaitForNasMountTarget(d.Id(), Active, DefaultTimeout)
+	err = nasService.WaitForNasMountTarget(d.Id(), Active, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -135,12 +141,12 @@ func resourceAlicloudNasMountTargetDelete(d *schema.ResourceData, meta interface
 	})
 
 	if err != nil {
-		if IsExceptedError(err, ForbiddenNasNotFound) {
+		if IsProductError(err, connectivity.NASCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
 	addDebug(request.GetActionName(), raw)
-	return WrapError(nasService.WaitForNasMountTarget(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(nasService.WaitForNasMountTarget(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
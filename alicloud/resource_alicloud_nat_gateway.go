@@ -23,6 +23,13 @@ func resourceAliyunNatGateway() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
@@ -108,6 +115,9 @@ func resourceAliyunNatGateway() *schema.Resource {
 				DiffSuppressFunc: ecsPostPaidDiffSuppressFunc,
 				ValidateFunc:     validateRouterInterfaceChargeTypePeriod,
 			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -155,12 +165,16 @@ func resourceAliyunNatGatewayCreate(d *schema.ResourceData, meta interface{}) er
 		request.Description = v.(string)
 	}
 
+	resend := clientTokenWait(client)
 	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateNatGateway(&args)
 		})
 		if err != nil {
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
 			if IsExceptedError(err, VswitchStatusError) || IsExceptedError(err, TaskConflict) {
 				return resource.RetryableError(err)
 			}
@@ -173,7 +187,11 @@ func resourceAliyunNatGatewayCreate(d *schema.ResourceData, meta interface{}) er
 	}); err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_nat_gateway", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	if err := vpcService.WaitForNatGateway(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNatGateway(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapError(err)
+	}
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, VpcTagProduct, TagResourceNatGateway); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunNatGatewayRead(d, meta)
@@ -209,7 +227,12 @@ func resourceAliyunNatGatewayRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("bandwidth_packages", bindWidthPackages)
 	}
 
-	return nil
+	tagService := TagService{client}
+	tags, err := tagService.DescribeTags(VpcTagProduct, TagResourceNatGateway, d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	return WrapError(setResourceTags(client, d, tags))
 }
 
 func resourceAliyunNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -223,6 +246,11 @@ func resourceAliyunNatGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.Partial(true)
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, VpcTagProduct, TagResourceNatGateway); err != nil {
+		return WrapError(err)
+	}
+
 	attributeUpdate := false
 	modifyNatGatewayAttributeRequest := vpc.CreateModifyNatGatewayAttributeRequest()
 	modifyNatGatewayAttributeRequest.RegionId = natGateway.RegionId
@@ -281,7 +309,7 @@ func resourceAliyunNatGatewayUpdate(d *schema.ResourceData, meta interface{}) er
 		addDebug(modifyNatGatewaySpecRequest.GetActionName(), raw)
 	}
 	d.Partial(false)
-	if err := vpcService.WaitForNatGateway(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNatGateway(d.Id(), Available, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunNatGatewayRead(d, meta)
@@ -308,7 +336,7 @@ func resourceAliyunNatGatewayDelete(d *schema.ResourceData, meta interface{}) er
 			if IsExceptedError(err, DependencyViolationBandwidthPackages) {
 				return resource.RetryableError(err)
 			}
-			if IsExceptedError(err, InvalidNatGatewayIdNotFound) {
+			if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -319,7 +347,7 @@ func resourceAliyunNatGatewayDelete(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForNatGateway(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForNatGateway(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func deleteBandwidthPackages(d *schema.ResourceData, meta interface{}) error {
@@ -348,7 +376,7 @@ func deleteBandwidthPackages(d *schema.ResourceData, meta interface{}) error {
 				if e != nil {
 					if IsExceptedError(e, NatGatewayInvalidRegionId) {
 						return resource.NonRetryableError(e)
-					} else if IsExceptedError(e, InstanceNotExists) {
+					} else if IsProductError(e, connectivity.VPCCode, ErrorNotFound) {
 						return nil
 					}
 					err = e
@@ -402,9 +430,9 @@ func getPackage(packageId string, meta interface{}, d *schema.ResourceData) (pac
 	request.NatGatewayId = d.Id()
 	request.BandwidthPackageId = packageId
 
-	invoker := NewInvoker()
+	client := meta.(*connectivity.AliyunClient)
+	invoker := NewInvoker(client)
 	err = invoker.Run(func() error {
-		client := meta.(*connectivity.AliyunClient)
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeBandwidthPackages(request)
 		})
@@ -3,6 +3,7 @@ package alicloud
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +20,12 @@ func resourceAliyunNetworkAcl() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -67,9 +74,12 @@ func resourceAliyunNetworkAclCreate(d *schema.ResourceData, meta interface{}) er
 	}
 	request.ClientToken = buildClientToken(request.GetActionName())
 
-	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-		return vpcClient.CreateNetworkAcl(request)
-	})
+	// Network ACL names are not unique within a VPC.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.CreateNetworkAcl(request)
+		})
+	}, nil)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_network_acl", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
@@ -77,7 +87,7 @@ func resourceAliyunNetworkAclCreate(d *schema.ResourceData, meta interface{}) er
 	response, _ := raw.(*vpc.CreateNetworkAclResponse)
 	d.SetId(response.NetworkAclId)
 
-	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -125,7 +135,7 @@ func resourceAliyunNetworkAclUpdate(d *schema.ResourceData, meta interface{}) er
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -135,7 +145,7 @@ func resourceAliyunNetworkAclUpdate(d *schema.ResourceData, meta interface{}) er
 func resourceAliyunNetworkAclDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	vpcService := VpcService{client}
-	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNetworkAcl(d.Id(), Available, timeoutSeconds(d, schema.TimeoutDelete)); err != nil {
 		return WrapError(err)
 	}
 
@@ -151,5 +161,5 @@ func resourceAliyunNetworkAclDelete(d *schema.ResourceData, meta interface{}) er
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	return WrapError(vpcService.WaitForNetworkAcl(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForNetworkAcl(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -15,6 +15,14 @@ func resourceAliyunNetworkAclAttachment() *schema.Resource {
 		Read:   resourceAliyunNetworkAclAttachmentRead,
 		Update: resourceAliyunNetworkAclAttachmentUpdate,
 		Delete: resourceAliyunNetworkAclAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunNetworkAclAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -50,6 +58,33 @@ func resourceAliyunNetworkAclAttachmentCreate(d *schema.ResourceData, meta inter
 	return resourceAliyunNetworkAclAttachmentUpdate(d, meta)
 }
 
+// resourceAliyunNetworkAclAttachmentImport imports all of the resources associated with a network acl by its ID, because
+// the attachment ID is generated by the provider.
+func resourceAliyunNetworkAclAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*connectivity.AliyunClient)
+	vpcService := VpcService{client}
+	object, err := vpcService.DescribeNetworkAcl(d.Id())
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	var resources []map[string]interface{}
+	for _, e := range object.Resources.Resource {
+		resources = append(resources, map[string]interface{}{
+			"resource_id":   e.ResourceId,
+			"resource_type": e.ResourceType,
+		})
+	}
+	if len(resources) < 1 {
+		return nil, WrapError(Error("The network acl %s is not associated with any resource.", d.Id()))
+	}
+	d.Set("network_acl_id", object.NetworkAclId)
+	if err := d.Set("resources", resources); err != nil {
+		return nil, WrapError(err)
+	}
+	d.SetId(object.NetworkAclId + COLON_SEPARATED + resource.UniqueId())
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAliyunNetworkAclAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AliyunClient)
@@ -129,7 +164,7 @@ func resourceAliyunNetworkAclAttachmentUpdate(d *schema.ResourceData, meta inter
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 			}
 			addDebug(request.GetActionName, raw)
-			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, DefaultTimeout); err != nil {
+			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -166,7 +201,7 @@ func resourceAliyunNetworkAclAttachmentUpdate(d *schema.ResourceData, meta inter
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 			}
 			addDebug(request.GetActionName, raw)
-			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, DefaultTimeout); err != nil {
+			if err := vpcService.WaitForNetworkAclAttachment(request.NetworkAclId, vpcResource, Available, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -220,5 +255,5 @@ func resourceAliyunNetworkAclAttachmentDelete(d *schema.ResourceData, meta inter
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return vpcService.WaitForNetworkAclAttachment(networkAclId, vpcResource, Deleted, DefaultTimeout)
+	return vpcService.WaitForNetworkAclAttachment(networkAclId, vpcResource, Deleted, timeoutSeconds(d, schema.TimeoutDelete))
 }
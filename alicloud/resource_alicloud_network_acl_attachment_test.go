@@ -133,6 +133,15 @@ func TestAccAlicloudNetworkAclAttachment_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateIdFunc: importStateIdFromAttributes(resourceId, "network_acl_id"),
+				ImportStateCheck: importStateCheckAttributes(map[string]string{
+					"network_acl_id": CHECKSET,
+					"resources.#":    "1",
+				}),
+			},
 			{
 				Config: testAccNetworkAclAttachment_associate(rand),
 				Check: resource.ComposeTestCheckFunc(
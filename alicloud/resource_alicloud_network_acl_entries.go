@@ -17,6 +17,14 @@ func resourceAliyunNetworkAclEntries() *schema.Resource {
 		Read:   resourceAliyunNetworkAclEntriesRead,
 		Update: resourceAliyunNetworkAclEntriesUpdate,
 		Delete: resourceAliyunNetworkAclEntriesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunNetworkAclEntriesImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -108,6 +116,56 @@ func resourceAliyunNetworkAclEntriesCreate(d *schema.ResourceData, meta interfac
 	return resourceAliyunNetworkAclEntriesUpdate(d, meta)
 }
 
+// resourceAliyunNetworkAclEntriesImport imports the custom entries of a network acl by its ID, because the ID of the
+// entries is generated by the provider. The system entries are managed by the network acl itself and skipped.
+func resourceAliyunNetworkAclEntriesImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*connectivity.AliyunClient)
+	vpcService := VpcService{client}
+	object, err := vpcService.DescribeNetworkAcl(d.Id())
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	ingress := []map[string]interface{}{}
+	for _, e := range object.IngressAclEntries.IngressAclEntry {
+		if e.EntryType != "custom" {
+			continue
+		}
+		ingress = append(ingress, map[string]interface{}{
+			"protocol":       e.Protocol,
+			"port":           e.Port,
+			"source_cidr_ip": e.SourceCidrIp,
+			"name":           e.NetworkAclEntryName,
+			"entry_type":     e.EntryType,
+			"policy":         e.Policy,
+			"description":    e.Description,
+		})
+	}
+	egress := []map[string]interface{}{}
+	for _, e := range object.EgressAclEntries.EgressAclEntry {
+		if e.EntryType != "custom" {
+			continue
+		}
+		egress = append(egress, map[string]interface{}{
+			"protocol":            e.Protocol,
+			"port":                e.Port,
+			"destination_cidr_ip": e.DestinationCidrIp,
+			"name":                e.NetworkAclEntryName,
+			"entry_type":          e.EntryType,
+			"policy":              e.Policy,
+			"description":         e.Description,
+		})
+	}
+	d.Set("network_acl_id", object.NetworkAclId)
+	if err := d.Set("ingress", ingress); err != nil {
+		return nil, WrapError(err)
+	}
+	if err := d.Set("egress", egress); err != nil {
+		return nil, WrapError(err)
+	}
+	d.SetId(object.NetworkAclId + COLON_SEPARATED + resource.UniqueId())
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAliyunNetworkAclEntriesRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	vpcService := VpcService{client}
@@ -204,7 +262,7 @@ func resourceAliyunNetworkAclEntriesUpdate(d *schema.ResourceData, meta interfac
 		request.UpdateEgressAclEntries = requests.NewBoolean(true)
 	}
 	// Check the network acl status.
-	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 		return WrapError(err)
 	}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -223,7 +281,7 @@ func resourceAliyunNetworkAclEntriesUpdate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout)
+	return vpcService.WaitForNetworkAcl(networkAclId, Available, timeoutSeconds(d, schema.TimeoutUpdate))
 }
 
 func resourceAliyunNetworkAclEntriesDelete(d *schema.ResourceData, meta interface{}) error {
@@ -244,7 +302,7 @@ func resourceAliyunNetworkAclEntriesDelete(d *schema.ResourceData, meta interfac
 	request.UpdateIngressAclEntries = requests.NewBoolean(true)
 	request.UpdateEgressAclEntries = requests.NewBoolean(true)
 	// Check the network acl status.
-	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, timeoutSeconds(d, schema.TimeoutDelete)); err != nil {
 		return WrapError(err)
 	}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
@@ -263,5 +321,5 @@ func resourceAliyunNetworkAclEntriesDelete(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout)
+	return vpcService.WaitForNetworkAcl(networkAclId, Available, timeoutSeconds(d, schema.TimeoutDelete))
 }
@@ -142,6 +142,20 @@ func TestAccAlicloudNetworkAclEntries_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateIdFunc: importStateIdFromAttributes(resourceId, "network_acl_id"),
+				ImportStateCheck: importStateCheckAttributes(map[string]string{
+					"network_acl_id":               CHECKSET,
+					"ingress.#":                    "1",
+					"egress.#":                     "1",
+					"ingress.0.name":               "tf-testAcc_network_acl",
+					"ingress.0.source_cidr_ip":     "0.0.0.0/32",
+					"egress.0.name":                "tf-testAcc_network_acl",
+					"egress.0.destination_cidr_ip": "0.0.0.0/32",
+				}),
+			},
 			{
 				Config: testAccNetworkAclEntries_modify(rand),
 				Check: resource.ComposeTestCheckFunc(
@@ -22,6 +22,13 @@ func resourceAliyunNetworkInterface() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -67,7 +74,8 @@ func resourceAliyunNetworkInterface() *schema.Resource {
 				Optional: true,
 				Default:  "",
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -94,9 +102,12 @@ func resourceAliyunNetworkInterfaceCreate(d *schema.ResourceData, meta interface
 		request.Description = description.(string)
 	}
 	request.ClientToken = buildClientToken(request.GetActionName())
-	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-		return ecsClient.CreateNetworkInterface(request)
-	})
+	// ENI names are not unique and DescribeNetworkInterfaces has no token filter.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.CreateNetworkInterface(request)
+		})
+	}, nil)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_network_interface", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
@@ -104,7 +115,7 @@ func resourceAliyunNetworkInterfaceCreate(d *schema.ResourceData, meta interface
 	object := raw.(*ecs.CreateNetworkInterfaceResponse)
 	d.SetId(object.NetworkInterfaceId)
 
-	if err := ecsService.WaitForNetworkInterface(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := ecsService.WaitForNetworkInterface(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -143,8 +154,8 @@ func resourceAliyunNetworkInterfaceRead(d *schema.ResourceData, meta interface{}
 		return WrapError(err)
 	}
 
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
+	if err := setResourceTags(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
 	}
 
 	return nil
@@ -341,5 +352,5 @@ func resourceAliyunNetworkInterfaceDelete(d *schema.ResourceData, meta interface
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ecsService.WaitForNetworkInterface(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(ecsService.WaitForNetworkInterface(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -19,6 +19,11 @@ func resourceAliyunNetworkInterfaceAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -61,7 +66,7 @@ func resourceAliyunNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_netWork_interface_attachment", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	d.SetId(eniId + COLON_SEPARATED + instanceId)
-	if err = ecsService.WaitForNetworkInterface(eniId, InUse, DefaultTimeout); err != nil {
+	if err = ecsService.WaitForNetworkInterface(eniId, InUse, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunNetworkInterfaceAttachmentRead(d, meta)
@@ -123,5 +128,5 @@ func resourceAliyunNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ecsService.WaitForNetworkInterface(eniId, Available, DefaultTimeoutMedium))
+	return WrapError(ecsService.WaitForNetworkInterface(eniId, Available, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ons"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -17,6 +19,11 @@ func resourceAlicloudOnsGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -67,7 +74,7 @@ func resourceAlicloudOnsGroupCreate(d *schema.ResourceData, meta interface{}) er
 	addDebug(request.GetActionName(), raw)
 	d.SetId(instanceId + ":" + groupId)
 
-	if err = onsService.WaitForOnsGroup(d.Id(), Available, DefaultTimeout); err != nil {
+	if err = onsService.WaitForOnsGroup(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	return resourceAlicloudOnsGroupRead(d, meta)
@@ -146,12 +153,12 @@ func resourceAlicloudOnsGroupDelete(d *schema.ResourceData, meta interface{}) er
 		return onsClient.OnsGroupDelete(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{AuthResourceOwnerError}) {
+		if IsProductError(err, connectivity.ONSCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(onsService.WaitForOnsGroup(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(onsService.WaitForOnsGroup(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -24,6 +24,12 @@ func resourceAlicloudOssBucket() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:         schema.TypeString,
@@ -232,7 +238,8 @@ func resourceAlicloudOssBucket() *schema.Resource {
 				MaxItems: 1,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 
 			"force_destroy": {
 				Type:     schema.TypeBool,
@@ -356,7 +363,7 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 	raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
 		return ossClient.GetBucketCORS(d.Id())
 	})
-	if err != nil && !IsExceptedErrors(err, []string{NoSuchCORSConfiguration}) {
+	if err != nil && !IsProductError(err, connectivity.OSSCode, ErrorNotFound) {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetBucketCORS", AliyunOssGoSdk)
 	}
 	addDebug("GetBucketCORS", raw)
@@ -380,7 +387,7 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 	raw, err = client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
 		return ossClient.GetBucketWebsite(d.Id())
 	})
-	if err != nil && !IsExceptedErrors(err, []string{NoSuchWebsiteConfiguration}) {
+	if err != nil && !IsProductError(err, connectivity.OSSCode, ErrorNotFound) {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetBucketWebsite", AliyunOssGoSdk)
 	}
 	addDebug("GetBucketWebsite", raw)
@@ -531,7 +538,7 @@ func resourceAlicloudOssBucketRead(d *schema.ResourceData, meta interface{}) err
 			tagsMap[t.Key] = t.Value
 		}
 	}
-	if err := d.Set("tags", tagsMap); err != nil {
+	if err := setResourceTags(client, d, tagsMap); err != nil {
 		return WrapError(err)
 	}
 
@@ -603,11 +610,13 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("server_side_encryption_rule")
 	}
 
-	if d.HasChange("tags") {
-		if err := resourceAlicloudOssBucketTaggingUpdate(client, d); err != nil {
+	if _, n, changed := tagsChange(client, d); changed {
+		if err := resourceAlicloudOssBucketTaggingUpdate(client, d, n); err != nil {
 			return WrapError(err)
 		}
+		d.Set("tags_all", n)
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	if d.HasChange("versioning") {
@@ -908,9 +917,28 @@ func resourceAlicloudOssBucketEncryptionUpdate(client *connectivity.AliyunClient
 	return nil
 }
 
-func resourceAlicloudOssBucketTaggingUpdate(client *connectivity.AliyunClient, d *schema.ResourceData) error {
-	tagsMap := d.Get("tags").(map[string]interface{})
-	if tagsMap == nil || len(tagsMap) == 0 {
+func resourceAlicloudOssBucketTaggingUpdate(client *connectivity.AliyunClient, d *schema.ResourceData, tags map[string]interface{}) error {
+	tagsMap := make(map[string]interface{})
+	for k, v := range tags {
+		tagsMap[k] = v
+	}
+	// SetBucketTagging replaces all of the tags, and the ones ignored by the provider have to be kept.
+	if len(client.IgnoreTagKeys) > 0 || len(client.IgnoreTagKeyPrefixes) > 0 {
+		raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
+			return ossClient.GetBucketTagging(d.Id())
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetBucketTagging", AliyunOssGoSdk)
+		}
+		addDebug("GetBucketTagging", raw)
+		tagging, _ := raw.(oss.GetBucketTaggingResult)
+		for _, t := range tagging.Tags {
+			if tagIgnoredByProvider(client, t.Key) {
+				tagsMap[t.Key] = t.Value
+			}
+		}
+	}
+	if len(tagsMap) == 0 {
 		raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
 			return nil, ossClient.DeleteBucketTagging(d.Id())
 		})
@@ -1025,7 +1053,7 @@ func resourceAlicloudOssBucketDelete(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteBucket", AliyunOssGoSdk)
 	}
-	return WrapError(ossService.WaitForOssBucket(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(ossService.WaitForOssBucket(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func expirationHash(v interface{}) int {
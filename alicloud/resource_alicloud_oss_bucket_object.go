@@ -20,6 +20,13 @@ func resourceAlicloudOssBucketObject() *schema.Resource {
 		Read:   resourceAlicloudOssBucketObjectRead,
 		Update: resourceAlicloudOssBucketObjectPut,
 		Delete: resourceAlicloudOssBucketObjectDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudOssBucketObjectImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -158,6 +165,19 @@ func resourceAlicloudOssBucketObjectPut(d *schema.ResourceData, meta interface{}
 	return resourceAlicloudOssBucketObjectRead(d, meta)
 }
 
+// resourceAlicloudOssBucketObjectImport imports an object by the ID <bucket>:<key>, because the object ID is its key,
+// which cannot locate the object without its bucket.
+func resourceAlicloudOssBucketObjectImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	d.Set("bucket", parts[0])
+	d.Set("key", parts[1])
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAlicloudOssBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
@@ -175,7 +195,7 @@ func resourceAlicloudOssBucketObjectRead(d *schema.ResourceData, meta interface{
 
 	object, err := bucket.GetObjectDetailedMeta(d.Get("key").(string), options...)
 	if err != nil {
-		if IsExceptedError(err, OssBodyNotFound) {
+		if IsProductError(err, connectivity.OSSCode, ErrorNotFound) || IsExceptedError(err, OssBodyNotFound) {
 			d.SetId("")
 			return WrapError(Error("To get the Object: %#v but it is not exist in the specified bucket %s.", d.Get("key").(string), d.Get("bucket").(string)))
 		}
@@ -215,7 +235,7 @@ func resourceAlicloudOssBucketObjectDelete(d *schema.ResourceData, meta interfac
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteObject", AliyunOssGoSdk)
 	}
 
-	return WrapError(ossService.WaitForOssBucketObject(bucket, d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(ossService.WaitForOssBucketObject(bucket, d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 
 }
 
@@ -62,6 +62,13 @@ func TestAccAlicloudOssBucketObject_basic(t *testing.T) {
 					}),
 				),
 			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       importStateIdFromAttributes(resourceId, "bucket", "key"),
+				ImportStateVerifyIgnore: []string{"source", "content", "acl"},
+			},
 			{
 				Config: testAccConfig(map[string]interface{}{
 					"source":  REMOVEKEY,
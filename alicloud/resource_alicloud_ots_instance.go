@@ -20,6 +20,13 @@ func resourceAlicloudOtsInstance() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -54,7 +61,8 @@ func resourceAlicloudOtsInstance() *schema.Resource {
 					return d.Id() != ""
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -92,7 +100,7 @@ func resourceAliyunOtsInstanceCreate(d *schema.ResourceData, meta interface{}) e
 	}
 
 	d.SetId(req.InstanceName)
-	if err := otsService.WaitForOtsInstance(req.InstanceName, Running, DefaultTimeout); err != nil {
+	if err := otsService.WaitForOtsInstance(req.InstanceName, Running, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return err
 	}
 	return resourceAliyunOtsInstanceUpdate(d, meta)
@@ -114,7 +122,9 @@ func resourceAliyunOtsInstanceRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("accessed_by", convertInstanceAccessedByRevert(inst.Network))
 	d.Set("instance_type", convertInstanceTypeRevert(inst.ClusterType))
 	d.Set("description", inst.Description)
-	d.Set("tags", otsTagsToMap(inst.TagInfos.TagInfo))
+	if err := setResourceTags(client, d, otsTagsToMap(inst.TagInfos.TagInfo)); err != nil {
+		return WrapError(err)
+	}
 	return nil
 }
 
@@ -137,10 +147,7 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("accessed_by")
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	if o, n, changed := tagsChange(client, d); changed {
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
 
 		if len(remove) > 0 {
@@ -180,9 +187,11 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 				return fmt.Errorf("Insertting tags got error: %s", err)
 			}
 		}
+		d.Set("tags_all", n)
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
-	if err := otsService.WaitForOtsInstance(d.Id(), Running, DefaultTimeout); err != nil {
+	if err := otsService.WaitForOtsInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 		return err
 	}
 	d.Partial(false)
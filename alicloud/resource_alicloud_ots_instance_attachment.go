@@ -15,6 +15,9 @@ func resourceAlicloudOtsInstanceAttachment() *schema.Resource {
 		Create: resourceAliyunOtsInstanceAttachmentCreate,
 		Read:   resourceAliyunOtsInstanceAttachmentRead,
 		Delete: resourceAliyunOtsInstanceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAliyunOtsInstanceAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -67,6 +70,18 @@ func resourceAliyunOtsInstanceAttachmentCreate(d *schema.ResourceData, meta inte
 	return resourceAliyunOtsInstanceAttachmentRead(d, meta)
 }
 
+// resourceAliyunOtsInstanceAttachmentImport imports an attachment by the ID <instance_name>:<vswitch_id>, because the
+// vswitch of the instance is not returned by the API.
+func resourceAliyunOtsInstanceAttachmentImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	d.Set("vswitch_id", parts[1])
+	d.SetId(parts[0])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAliyunOtsInstanceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	otsService := OtsService{client}
@@ -78,8 +93,8 @@ func resourceAliyunOtsInstanceAttachmentRead(d *schema.ResourceData, meta interf
 		}
 		return fmt.Errorf("failed to describe instance vpc with error: %s", err)
 	}
-	// There is a bug that inst does not contain instance name and vswitch ID, so this resource does not support import function.
-	//d.Set("instance_name", inst.InstanceName)
+	// The instance name and vswitch ID are not contained in inst, and the vswitch ID is set by the importer.
+	d.Set("instance_name", d.Id())
 	d.Set("vpc_name", inst.InstanceVpcName)
 	d.Set("vpc_id", inst.VpcId)
 	return nil
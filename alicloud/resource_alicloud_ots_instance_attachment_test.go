@@ -39,6 +39,12 @@ func TestAccAlicloudOtsInstanceCapacityAttachment(t *testing.T) {
 					resource.TestCheckResourceAttrSet("alicloud_ots_instance_attachment.foo", "vpc_id"),
 				),
 			},
+			{
+				ResourceName:      "alicloud_ots_instance_attachment.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: importStateIdFromAttributes("alicloud_ots_instance_attachment.foo", "instance_name", "vswitch_id"),
+			},
 		},
 	})
 
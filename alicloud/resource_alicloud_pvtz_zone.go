@@ -20,6 +20,11 @@ func resourceAlicloudPvtzZone() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -58,7 +63,7 @@ func resourceAlicloudPvtzZoneCreate(d *schema.ResourceData, meta interface{}) er
 		request.ZoneName = v.(string)
 	}
 	// API AddZone has a throttling limitation 5qps which one use only can send 5 requests in one second.
-	invoker := PvtzInvoker()
+	invoker := PvtzInvoker(client)
 	var raw interface{}
 	var err error
 	if err := invoker.Run(func() error {
@@ -110,7 +115,7 @@ func resourceAlicloudPvtzZoneUpdate(d *schema.ResourceData, meta interface{}) er
 		request.Remark = d.Get("remark").(string)
 
 		client := meta.(*connectivity.AliyunClient)
-		invoker := PvtzInvoker()
+		invoker := PvtzInvoker(client)
 		err := invoker.Run(func() error {
 			raw, err := client.WithPvtzClient(func(pvtzClient *pvtz.Client) (interface{}, error) {
 				return pvtzClient.UpdateZoneRemark(request)
@@ -133,17 +138,21 @@ func resourceAlicloudPvtzZoneDelete(d *schema.ResourceData, meta interface{}) er
 	request := pvtz.CreateDeleteZoneRequest()
 	request.ZoneId = d.Id()
 
+	wait := incrementalWait(client, 2*time.Second, 2*time.Second)
+	waitDependency := dependencyWait(client, 2*time.Second)
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithPvtzClient(func(pvtzClient *pvtz.Client) (interface{}, error) {
 			return pvtzClient.DeleteZone(request)
 		})
 
 		if err != nil {
-			if IsExceptedErrors(err, []string{PvtzThrottlingUser, PvtzSystemBusy, ZoneVpcExists}) {
-				time.Sleep(time.Duration(2) * time.Second)
+			if IsExceptedErrors(err, []string{PvtzThrottlingUser, PvtzSystemBusy}) && wait() {
+				return resource.RetryableError(err)
+			}
+			if IsExceptedError(err, ZoneVpcExists) && waitDependency() {
 				return resource.RetryableError(err)
 			}
-			if IsExceptedErrors(err, []string{ZoneNotExists, ZoneVpcNotExists}) {
+			if IsProductError(err, connectivity.PVTZCode, ErrorNotFound) {
 				return nil
 			}
 			return resource.NonRetryableError(err)
@@ -158,5 +167,5 @@ func resourceAlicloudPvtzZoneDelete(d *schema.ResourceData, meta interface{}) er
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(pvtzService.WaitForPvtzZone(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(pvtzService.WaitForPvtzZone(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -19,6 +19,11 @@ func resourceAlicloudPvtzZoneAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:     schema.TypeString,
@@ -81,7 +86,7 @@ func resourceAlicloudPvtzZoneAttachmentUpdate(d *schema.ResourceData, meta inter
 		}
 
 		request.Vpcs = &vpcs
-		invoker := PvtzInvoker()
+		invoker := PvtzInvoker(client)
 		invoker.AddCatcher(Catcher{ZoneNotExists, 30, 3})
 		if err := invoker.Run(func() error {
 			raw, err := client.WithPvtzClient(func(pvtzClient *pvtz.Client) (interface{}, error) {
@@ -94,7 +99,7 @@ func resourceAlicloudPvtzZoneAttachmentUpdate(d *schema.ResourceData, meta inter
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 
-		if err := pvtzService.WaitForZoneAttachment(d.Id(), vpcIdMap, DefaultTimeout); err != nil {
+		if err := pvtzService.WaitForZoneAttachment(d.Id(), vpcIdMap, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -140,17 +145,17 @@ func resourceAlicloudPvtzZoneAttachmentDelete(d *schema.ResourceData, meta inter
 	vpcs := make([]pvtz.BindZoneVpcVpcs, 0)
 	request.Vpcs = &vpcs
 
+	wait := incrementalWait(client, 2*time.Second, 2*time.Second)
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithPvtzClient(func(pvtzClient *pvtz.Client) (interface{}, error) {
 			return pvtzClient.BindZoneVpc(request)
 		})
 
 		if err != nil {
-			if IsExceptedErrors(err, []string{ZoneNotExists, ZoneVpcNotExists}) {
+			if IsProductError(err, connectivity.PVTZCode, ErrorNotFound) {
 				return nil
 			}
-			if IsExceptedErrors(err, []string{PvtzThrottlingUser, PvtzSystemBusy}) {
-				time.Sleep(time.Duration(2) * time.Second)
+			if IsExceptedErrors(err, []string{PvtzThrottlingUser, PvtzSystemBusy}) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -162,5 +167,5 @@ func resourceAlicloudPvtzZoneAttachmentDelete(d *schema.ResourceData, meta inter
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(pvtzService.WaitForPvtzZoneAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(pvtzService.WaitForPvtzZoneAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
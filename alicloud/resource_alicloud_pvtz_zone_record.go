@@ -22,6 +22,11 @@ func resourceAlicloudPvtzZoneRecord() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"resource_record": {
 				Type:     schema.TypeString,
@@ -95,7 +100,7 @@ func resourceAlicloudPvtzZoneRecordCreate(d *schema.ResourceData, meta interface
 	}
 
 	// API AddZoneRecord has a throttling limitation 20qps which one use only can send 20 requests in one second.
-	invoker := PvtzInvoker()
+	invoker := PvtzInvoker(client)
 	var raw interface{}
 	var err error
 	err = invoker.Run(func() error {
@@ -191,7 +196,7 @@ func resourceAlicloudPvtzZoneRecordUpdate(d *schema.ResourceData, meta interface
 
 	if update {
 		client := meta.(*connectivity.AliyunClient)
-		invoker := PvtzInvoker()
+		invoker := PvtzInvoker(client)
 
 		if err := invoker.Run(func() error {
 			raw, err := client.WithPvtzClient(func(pvtzClient *pvtz.Client) (interface{}, error) {
@@ -252,17 +257,17 @@ func resourceAlicloudPvtzZoneRecordDelete(d *schema.ResourceData, meta interface
 	}
 	request.RecordId = requests.NewInteger(recordId)
 
+	wait := incrementalWait(client, 2*time.Second, 2*time.Second)
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithPvtzClient(func(pvtzClient *pvtz.Client) (interface{}, error) {
 			return pvtzClient.DeleteZoneRecord(request)
 		})
 
 		if err != nil {
-			if IsExceptedErrors(err, []string{ZoneNotExists, ZoneVpcNotExists}) {
+			if IsProductError(err, connectivity.PVTZCode, ErrorNotFound) {
 				return nil
 			}
-			if IsExceptedErrors(err, []string{PvtzThrottlingUser, PvtzSystemBusy}) {
-				time.Sleep(time.Duration(2) * time.Second)
+			if IsExceptedErrors(err, []string{PvtzThrottlingUser, PvtzSystemBusy}) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -276,7 +281,7 @@ func resourceAlicloudPvtzZoneRecordDelete(d *schema.ResourceData, meta interface
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(pvtzService.WaitForPvtzZoneRecord(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(pvtzService.WaitForPvtzZoneRecord(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func getRecordIdAndZoneId(d *schema.ResourceData, meta interface{}) (string, string, error) {
@@ -1,6 +1,9 @@
 package alicloud
 
 import (
+	"strings"
+	"time"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/hashicorp/terraform/helper/encryption"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,6 +16,14 @@ func resourceAlicloudRamAccessKey() *schema.Resource {
 		Read:   resourceAlicloudRamAccessKeyRead,
 		Update: resourceAlicloudRamAccessKeyUpdate,
 		Delete: resourceAlicloudRamAccessKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAlicloudRamAccessKeyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user_name": {
@@ -85,7 +96,7 @@ func resourceAlicloudRamAccessKeyCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	d.SetId(response.AccessKey.AccessKeyId)
-	err = ramService.WaitForRamAccessKey(d.Id(), request.UserName, Active, DefaultTimeout)
+	err = ramService.WaitForRamAccessKey(d.Id(), request.UserName, Active, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -115,6 +126,20 @@ func resourceAlicloudRamAccessKeyUpdate(d *schema.ResourceData, meta interface{}
 	return resourceAlicloudRamAccessKeyRead(d, meta)
 }
 
+// resourceAlicloudRamAccessKeyImport imports an access key by its ID, or by the ID <user_name>:<access_key_id> for an
+// access key of a RAM user. The secret of an access key can never be read again, so it is not imported.
+func resourceAlicloudRamAccessKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), COLON_SEPARATED) {
+		parts, err := ParseResourceId(d.Id(), 2)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		d.Set("user_name", parts[0])
+		d.SetId(parts[1])
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAlicloudRamAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	ramservice := RamService{client}
@@ -154,6 +179,6 @@ func resourceAlicloudRamAccessKeyDelete(d *schema.ResourceData, meta interface{}
 		return WrapErrorf(err, DefaultErrorMsg, request.UserName, request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	return WrapError(ramService.WaitForRamAccessKey(d.Id(), request.UserName, Deleted, DefaultTimeoutMedium))
+	return WrapError(ramService.WaitForRamAccessKey(d.Id(), request.UserName, Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 
 }
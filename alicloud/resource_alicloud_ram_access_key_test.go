@@ -39,6 +39,13 @@ func TestAccAlicloudRamAccessKey_basic(t *testing.T) {
 					testAccCheck(map[string]string{"user_name": fmt.Sprintf("tf-testAcc%sRamAccessKeyConfig%d", defaultRegionToTest, rand)}),
 				),
 			},
+			{
+				ResourceName:            resourceAKId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       importStateIdFromAttributes(resourceAKId, "user_name", "id"),
+				ImportStateVerifyIgnore: []string{"secret_file", "pgp_key", "key_fingerprint", "encrypted_secret"},
+			},
 			{
 				Config: testAccRamAccessKeyPgp(rand),
 				Check: resource.ComposeTestCheckFunc(
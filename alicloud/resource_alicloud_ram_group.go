@@ -19,6 +19,11 @@ func resourceAlicloudRamGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -56,7 +61,7 @@ func resourceAlicloudRamGroupCreate(d *schema.ResourceData, meta interface{}) er
 	addDebug(request.GetActionName(), raw)
 	response, _ := raw.(*ram.CreateGroupResponse)
 	d.SetId(response.Group.GroupName)
-	err = ramSercvice.WaitForRamGroup(d.Id(), Normal, DefaultTimeout)
+	err = ramSercvice.WaitForRamGroup(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -187,5 +192,5 @@ func resourceAlicloudRamGroupDelete(d *schema.ResourceData, meta interface{}) er
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), deleteGroupRequest.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ramService.WaitForRamGroup(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamGroup(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
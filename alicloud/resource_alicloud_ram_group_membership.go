@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
@@ -12,6 +14,13 @@ func resourceAlicloudRamGroupMembership() *schema.Resource {
 		Read:   resourceAlicloudRamGroupMembershipRead,
 		Update: resourceAlicloudRamGroupMembershipUpdate,
 		Delete: resourceAlicloudRamGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_name": {
@@ -113,7 +122,7 @@ func resourceAlicloudRamGroupMembershipDelete(d *schema.ResourceData, meta inter
 	if err := removeUsersFromGroup(client, users, group); err != nil {
 		return WrapError(err)
 	}
-	return WrapError(ramService.WaitForRamGroupMembership(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamGroupMembership(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func addUsersToGroup(client *connectivity.AliyunClient, users []string, group string) error {
@@ -39,6 +39,11 @@ func TestAccAlicloudRamGroupMembership_basic(t *testing.T) {
 					testAccCheck(map[string]string{"group_name": fmt.Sprintf("tf-testAcc%sRamGroupMembershipConfig-%d", defaultRegionToTest, rand)}),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccRamGroupMembershipUserNameConfig(rand),
 				Check: resource.ComposeTestCheckFunc(
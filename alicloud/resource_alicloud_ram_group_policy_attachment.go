@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,6 +14,13 @@ func resourceAlicloudRamGroupPolicyAtatchment() *schema.Resource {
 		Create: resourceAlicloudRamGroupPolicyAttachmentCreate,
 		Read:   resourceAlicloudRamGroupPolicyAttachmentRead,
 		Delete: resourceAlicloudRamGroupPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: importStateParseResourceId(4),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"group_name": {
@@ -110,6 +118,6 @@ func resourceAlicloudRamGroupPolicyAttachmentDelete(d *schema.ResourceData, meta
 
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(ramService.WaitForRamGroupPolicyAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamGroupPolicyAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 
 }
@@ -45,6 +45,11 @@ func TestAccAlicloudRamGroupPolicyAttachment_basic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
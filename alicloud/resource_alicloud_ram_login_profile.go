@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
@@ -19,6 +20,11 @@ func resourceAlicloudRamLoginProfile() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"user_name": {
 				Type:     schema.TypeString,
@@ -66,7 +72,7 @@ func resourceAlicloudRamLoginProfileCreate(d *schema.ResourceData, meta interfac
 	addDebug(request.GetActionName(), raw)
 
 	d.SetId(request.UserName)
-	err = ramSercvice.WaitForRamLoginProfile(d.Id(), Normal, DefaultTimeout)
+	err = ramSercvice.WaitForRamLoginProfile(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -135,6 +141,6 @@ func resourceAlicloudRamLoginProfileDelete(d *schema.ResourceData, meta interfac
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(ramService.WaitForRamLoginProfile(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamLoginProfile(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 
 }
@@ -15,6 +15,10 @@ func resourceAlicloudRamRoleAttachment() *schema.Resource {
 		Read:   resourceAlicloudInstanceRoleAttachmentRead,
 		Delete: resourceAlicloudInstanceRoleAttachmentDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:     schema.TypeString,
@@ -121,5 +125,5 @@ func resourceAlicloudInstanceRoleAttachmentDelete(d *schema.ResourceData, meta i
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(ramService.WaitForRamRoleAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamRoleAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -14,6 +15,13 @@ func resourceAlicloudRamRolePolicyAttachment() *schema.Resource {
 		Read:   resourceAlicloudRamRolePolicyAttachmentRead,
 		//Update: resourceAlicloudRamRolePolicyAttachmentUpdate,
 		Delete: resourceAlicloudRamRolePolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: importStateParseResourceId(4),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": {
@@ -112,5 +120,5 @@ func resourceAlicloudRamRolePolicyAttachmentDelete(d *schema.ResourceData, meta
 
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(ramService.WaitForRamRolePolicyAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamRolePolicyAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
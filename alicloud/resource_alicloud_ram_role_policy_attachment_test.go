@@ -46,6 +46,11 @@ func TestAccAlicloudRamRolePolicyAttachment_basic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
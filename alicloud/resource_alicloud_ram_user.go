@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"time"
+
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
@@ -16,6 +18,11 @@ func resourceAlicloudRamUser() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -77,7 +84,7 @@ func resourceAlicloudRamUserCreate(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(response.User.UserId)
 
-	err = ramService.WaitForRamUser(d.Id(), Normal, DefaultTimeout)
+	err = ramService.WaitForRamUser(d.Id(), Normal, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -283,5 +290,5 @@ func resourceAlicloudRamUserDelete(d *schema.ResourceData, meta interface{}) err
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(deleteUserRequest.GetActionName(), raw)
-	return WrapError(ramService.WaitForRamUser(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamUser(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
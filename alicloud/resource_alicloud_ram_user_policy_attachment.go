@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -13,6 +14,13 @@ func resourceAlicloudRamUserPolicyAtatchment() *schema.Resource {
 		Create: resourceAlicloudRamUserPolicyAttachmentCreate,
 		Read:   resourceAlicloudRamUserPolicyAttachmentRead,
 		Delete: resourceAlicloudRamUserPolicyAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: importStateParseResourceId(4),
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user_name": {
@@ -113,6 +121,6 @@ func resourceAlicloudRamUserPolicyAttachmentDelete(d *schema.ResourceData, meta
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(ramService.WaitForRamUserPolicyAttachment(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ramService.WaitForRamUserPolicyAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 
 }
@@ -43,6 +43,11 @@ func TestAccAlicloudRamUserPolicyAttachment_basic(t *testing.T) {
 					testAccCheck(nil),
 				),
 			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
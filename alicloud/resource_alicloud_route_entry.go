@@ -18,6 +18,11 @@ func resourceAliyunRouteEntry() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"router_id": {
 				Type:       schema.TypeString,
@@ -71,8 +76,9 @@ func resourceAliyunRouteEntryCreate(d *schema.ResourceData, meta interface{}) er
 	request.ClientToken = buildClientToken(request.GetActionName())
 
 	// retry 10 min to create lots of entries concurrently
+	resend := clientTokenWait(client)
 	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
-		if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, DefaultTimeout); err != nil {
+		if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 			return resource.NonRetryableError(err)
 		}
 		args := *request
@@ -80,6 +86,9 @@ func resourceAliyunRouteEntryCreate(d *schema.ResourceData, meta interface{}) er
 			return vpcClient.CreateRouteEntry(&args)
 		})
 		if err != nil {
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
 			// Route Entry does not support concurrence when creating or deleting it;
 			// Route Entry does not support creating or deleting within 5 seconds frequently
 			// It must ensure all the route entries, vpc, vswitches' status must be available before creating or deleting route entry.
@@ -107,7 +116,7 @@ func resourceAliyunRouteEntryCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(rtId + ":" + table.VRouterId + ":" + cidr + ":" + nt + ":" + ni)
 
-	if err := vpcService.WaitForRouteEntry(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForRouteEntry(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunRouteEntryRead(d, meta)
@@ -146,7 +155,7 @@ func resourceAliyunRouteEntryDelete(d *schema.ResourceData, meta interface{}) er
 	vpcService := VpcService{client}
 	parts, err := ParseResourceId(d.Id(), 5)
 	rtId := parts[0]
-	if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, timeoutSeconds(d, schema.TimeoutDelete)); err != nil {
 		return WrapError(err)
 	}
 	retryTimes := 7
@@ -155,9 +164,11 @@ func resourceAliyunRouteEntryDelete(d *schema.ResourceData, meta interface{}) er
 			return vpcClient.DeleteRouteEntry(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{IncorrectVpcStatus, TaskConflict, IncorrectRouteEntryStatus, RouterEntryForbbiden, UnknownError}) {
+			if IsExceptedErrors(err, []string{IncorrectVpcStatus, TaskConflict, IncorrectRouteEntryStatus, RouterEntryForbbiden, UnknownError}) || IsExceptedError(err, RouterEntryForbbiden) {
 				// Route Entry does not support creating or deleting within 5 seconds frequently
-				time.Sleep(time.Duration(retryTimes) * time.Second)
+				if err := sleepContext(client, time.Duration(retryTimes)*time.Second); err != nil {
+					return resource.NonRetryableError(WrapError(err))
+				}
 				retryTimes += 7
 				return resource.RetryableError(err)
 			}
@@ -167,12 +178,12 @@ func resourceAliyunRouteEntryDelete(d *schema.ResourceData, meta interface{}) er
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidRouteEntryNotFound}) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForRouteEntry(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpcService.WaitForRouteEntry(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func buildAliyunRouteEntryDeleteArgs(d *schema.ResourceData, meta interface{}) (*vpc.DeleteRouteEntryRequest, error) {
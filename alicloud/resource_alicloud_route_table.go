@@ -3,6 +3,7 @@ package alicloud
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -19,6 +20,11 @@ func resourceAliyunRouteTable() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -63,9 +69,12 @@ func resourceAliyunRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 	request.Description = d.Get("description").(string)
 	request.ClientToken = buildClientToken(request.GetActionName())
 
-	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-		return vpcClient.CreateRouteTable(request)
-	})
+	// Route table names are not unique within a VPC.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.CreateRouteTable(request)
+		})
+	}, nil)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_route_table", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
@@ -73,7 +82,7 @@ func resourceAliyunRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 	response, _ := raw.(*vpc.CreateRouteTableResponse)
 	d.SetId(response.RouteTableId)
 
-	if err := vpcService.WaitForRouteTable(d.Id(), Available, DefaultTimeoutMedium); err != nil {
+	if err := vpcService.WaitForRouteTable(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -135,5 +144,5 @@ func resourceAliyunRouteTableDelete(d *schema.ResourceData, meta interface{}) er
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	return WrapError(routeTableService.WaitForRouteTable(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(routeTableService.WaitForRouteTable(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -17,6 +17,12 @@ func resourceAliyunRouteTableAttachment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
 				Type:     schema.TypeString,
@@ -58,11 +64,11 @@ func resourceAliyunRouteTableAttachmentCreate(d *schema.ResourceData, meta inter
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_route_table_attachment", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	d.SetId(request.RouteTableId + COLON_SEPARATED + request.VSwitchId)
-	err := vpcService.WaitForRouteTableAttachment(d.Id(), Available, DefaultTimeout)
+	err := vpcService.WaitForRouteTableAttachment(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
-	if err := vpcService.WaitForVSwitch(request.VSwitchId, Available, DefaultTimeoutMedium); err != nil {
+	if err := vpcService.WaitForVSwitch(request.VSwitchId, Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunRouteTableAttachmentRead(d, meta)
@@ -119,5 +125,5 @@ func resourceAliyunRouteTableAttachmentDelete(d *schema.ResourceData, meta inter
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForRouteTableAttachment(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForRouteTableAttachment(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -20,6 +20,11 @@ func resourceAlicloudRouterInterface() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"opposite_region": {
 				Type:     schema.TypeString,
@@ -129,9 +134,12 @@ func resourceAlicloudRouterInterfaceCreate(d *schema.ResourceData, meta interfac
 		return WrapError(err)
 	}
 
-	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-		return vpcClient.CreateRouterInterface(request)
-	})
+	// Router interfaces only get a name after they are created.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.CreateRouterInterface(request)
+		})
+	}, nil)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_router_interface", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
@@ -139,7 +147,7 @@ func resourceAlicloudRouterInterfaceCreate(d *schema.ResourceData, meta interfac
 	response, _ := raw.(*vpc.CreateRouterInterfaceResponse)
 	d.SetId(response.RouterInterfaceId)
 
-	if err := vpcService.WaitForRouterInterface(d.Id(), client.RegionId, Idle, 300); err != nil {
+	if err := vpcService.WaitForRouterInterface(d.Id(), client.RegionId, Idle, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -239,14 +247,14 @@ func resourceAlicloudRouterInterfaceDelete(d *schema.ResourceData, meta interfac
 	request.RegionId = string(client.Region)
 	request.RouterInterfaceId = d.Id()
 	request.ClientToken = buildClientToken(request.GetActionName())
+	wait := dependencyWait(client, 5*time.Second)
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteRouterInterface(&args)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{RouterInterfaceIncorrectStatus, DependencyViolationRouterInterfaceReferedByRouteEntry}) {
-				time.Sleep(5 * time.Second)
+			if IsExceptedErrors(err, []string{RouterInterfaceIncorrectStatus, DependencyViolationRouterInterfaceReferedByRouteEntry}) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -255,12 +263,12 @@ func resourceAlicloudRouterInterfaceDelete(d *schema.ResourceData, meta interfac
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidInstanceIdNotFound}) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 			return nil
 		}
 		WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForRouterInterface(d.Id(), client.RegionId, Deleted, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForRouterInterface(d.Id(), client.RegionId, Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func buildAlicloudRouterInterfaceCreateArgs(d *schema.ResourceData, meta interface{}) (*vpc.CreateRouterInterfaceRequest, error) {
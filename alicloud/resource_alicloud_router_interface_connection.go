@@ -19,6 +19,11 @@ func resourceAlicloudRouterInterfaceConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"interface_id": {
 				Type:     schema.TypeString,
@@ -82,7 +87,7 @@ func resourceAlicloudRouterInterfaceConnectionCreate(d *schema.ResourceData, met
 				return WrapError(err)
 			}
 			d.SetId(object.RouterInterfaceId)
-			if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, DefaultTimeout); err != nil {
+			if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 				return WrapError(err)
 			}
 			return resourceAlicloudRouterInterfaceConnectionRead(d, meta)
@@ -135,7 +140,7 @@ func resourceAlicloudRouterInterfaceConnectionCreate(d *schema.ResourceData, met
 	addDebug(request.GetActionName(), raw)
 	d.SetId(interfaceId)
 
-	if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Idle, DefaultTimeout); err != nil {
+	if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Idle, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -158,7 +163,7 @@ func resourceAlicloudRouterInterfaceConnectionCreate(d *schema.ResourceData, met
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), connectRequest.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 
-		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, DefaultTimeout); err != nil {
+		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), connectRequest.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 	}
@@ -182,7 +187,7 @@ func resourceAlicloudRouterInterfaceConnectionRead(d *schema.ResourceData, meta
 		if err := vpcService.ActivateRouterInterface(d.Id()); err != nil {
 			return WrapError(err)
 		}
-		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, DefaultTimeout); err != nil {
+		if err := vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -221,5 +226,5 @@ func resourceAlicloudRouterInterfaceConnectionDelete(d *schema.ResourceData, met
 		}
 	}
 
-	return WrapError(vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Inactive, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Inactive, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -19,6 +19,12 @@ func resourceAliyunSecurityGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -42,7 +48,8 @@ func resourceAliyunSecurityGroup() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -65,9 +72,12 @@ func resourceAliyunSecurityGroupCreate(d *schema.ResourceData, meta interface{})
 	}
 	request.ClientToken = buildClientToken(request.GetActionName())
 
-	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-		return ecsClient.CreateSecurityGroup(request)
-	})
+	// Security group names are not unique within a VPC.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.CreateSecurityGroup(request)
+		})
+	}, nil)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_security_group", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
@@ -98,8 +108,8 @@ func resourceAliyunSecurityGroupRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil && !NotFoundError(err) {
 		return WrapError(err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
+	if err := setResourceTags(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
 	}
 
 	return nil
@@ -196,6 +206,6 @@ func resourceAliyunSecurityGroupDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return WrapErrorf(err, DefaultTimeoutMsg, d.Id(), request.GetActionName(), ProviderERROR)
 	}
-	return WrapError(ecsService.WaitForSecurityGroup(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(ecsService.WaitForSecurityGroup(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 
 }
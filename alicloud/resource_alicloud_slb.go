@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -20,6 +21,13 @@ func resourceAliyunSlb() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -219,6 +227,8 @@ func resourceAliyunSlb() *schema.Resource {
 				ValidateFunc: validateSlbInstanceTagNum,
 			},
 
+			"tags_all": tagsAllSchema(),
+
 			"instance_charge_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -319,8 +329,9 @@ func resourceAliyunSlbCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	var raw interface{}
 
-	invoker := Invoker{}
+	invoker := Invoker{client: client}
 	invoker.AddCatcher(Catcher{SlbTokenIsProcessing, 10, 5})
+	invoker.AddCatcher(ClientTokenCatcher)
 
 	if err := invoker.Run(func() error {
 		resp, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -338,7 +349,7 @@ func resourceAliyunSlbCreate(d *schema.ResourceData, meta interface{}) error {
 	response, _ := raw.(*slb.CreateLoadBalancerResponse)
 	d.SetId(response.LoadBalancerId)
 
-	if err := slbService.WaitForSlb(response.LoadBalancerId, Active, DefaultTimeout); err != nil {
+	if err := slbService.WaitForSlb(response.LoadBalancerId, Active, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -383,10 +394,8 @@ func resourceAliyunSlbRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("delete_protection", object.DeleteProtection)
 	tags, _ := slbService.describeTags(d.Id())
-	if len(tags) > 0 {
-		if err := d.Set("tags", slbService.slbTagsToMap(tags)); err != nil {
-			return WrapError(err)
-		}
+	if err := setResourceTags(client, d, slbService.slbTagsToMap(tags)); err != nil {
+		return WrapError(err)
 	}
 	return nil
 }
@@ -526,12 +535,12 @@ func resourceAliyunSlbDelete(d *schema.ResourceData, meta interface{}) error {
 		return slbClient.DeleteLoadBalancer(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{LoadBalancerNotFound}) {
+		if IsProductError(err, connectivity.SLBCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(slbService.WaitForSlb(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(slbService.WaitForSlb(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -2,6 +2,7 @@ package alicloud
 
 import (
 	"strings"
+	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -18,6 +19,10 @@ func resourceAlicloudSlbAcl() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -78,7 +83,7 @@ func resourceAlicloudSlbAclRead(d *schema.ResourceData, meta interface{}) error
 
 	object, err := slbService.DescribeSlbAcl(d.Id())
 	if err != nil {
-		if IsExceptedError(err, SlbAclNotExists) {
+		if IsProductError(err, connectivity.SLBCode, ErrorNotFound) {
 			d.SetId("")
 			return nil
 		}
@@ -148,10 +153,10 @@ func resourceAlicloudSlbAclDelete(d *schema.ResourceData, meta interface{}) erro
 		return slbClient.DeleteAccessControlList(request)
 	})
 	if err != nil {
-		if !IsExceptedError(err, SlbAclNotExists) {
+		if !IsProductError(err, connectivity.SLBCode, ErrorNotFound) {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 	}
 	addDebug(request.GetActionName(), raw)
-	return WrapError(slbService.WaitForSlbAcl(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(slbService.WaitForSlbAcl(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -20,6 +20,10 @@ func resourceAliyunSlbAttachment() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 
 			"slb_id": {
@@ -222,5 +226,5 @@ func resourceAliyunSlbAttachmentDelete(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 	}
-	return WrapError(slbService.WaitSlbAttribute(d.Id(), instanceSet, DefaultTimeout))
+	return WrapError(slbService.WaitSlbAttribute(d.Id(), instanceSet, timeoutSeconds(d, schema.TimeoutDelete)))
 }
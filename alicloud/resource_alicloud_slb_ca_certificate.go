@@ -19,6 +19,10 @@ func resourceAlicloudSlbCACertificate() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -122,11 +126,11 @@ func resourceAlicloudSlbCACertificateDelete(d *schema.ResourceData, meta interfa
 		addDebug(request.GetActionName(), raw)
 		return nil
 	}); err != nil {
-		if IsExceptedError(err, SlbCACertificateIdNotFound) {
+		if IsProductError(err, connectivity.SLBCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(slbService.WaitForSlbCACertificate(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(slbService.WaitForSlbCACertificate(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
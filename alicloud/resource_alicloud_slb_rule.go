@@ -24,6 +24,10 @@ func resourceAliyunSlbRule() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -349,13 +353,13 @@ func resourceAliyunSlbRuleDelete(d *schema.ResourceData, meta interface{}) error
 		return slbClient.DeleteRules(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, InvalidRuleIdNotFound) {
+		if IsProductError(err, connectivity.SLBCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 
-	return WrapError(slbService.WaitForSlbRule(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(slbService.WaitForSlbRule(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 
 }
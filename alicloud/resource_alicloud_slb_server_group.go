@@ -22,6 +22,10 @@ func resourceAliyunSlbServerGroup() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
 				Type:     schema.TypeString,
@@ -329,10 +333,10 @@ func resourceAliyunSlbServerGroupDelete(d *schema.ResourceData, meta interface{}
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{VServerGroupNotFoundMessage, InvalidParameter}) {
+		if IsProductError(err, connectivity.SLBCode, ErrorNotFound) || IsExceptedError(err, InvalidParameter) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(slbService.WaitForSlbServerGroup(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(slbService.WaitForSlbServerGroup(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -25,6 +25,8 @@ func resourceAliyunSnapshot() *schema.Resource {
 			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"disk_id": &schema.Schema{
 				Type:     schema.TypeString,
@@ -41,7 +43,8 @@ func resourceAliyunSnapshot() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -59,9 +62,12 @@ func resourceAliyunSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 		request.Description = description.(string)
 	}
 
-	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-		return ecsClient.CreateSnapshot(request)
-	})
+	// Snapshot names are not unique for a disk.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.CreateSnapshot(request)
+		})
+	}, nil)
 	if err != nil {
 		return WrapErrorf(err, DefaultDebugMsg, "alicloud_snapshot", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
@@ -71,7 +77,7 @@ func resourceAliyunSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 
 	ecsService := EcsService{client}
 
-	stateConf := BuildStateConf([]string{}, []string{string(SnapshotCreatingAccomplished)}, d.Timeout(schema.TimeoutCreate), 0,
+	stateConf := BuildStateConf(client, []string{}, []string{string(SnapshotCreatingAccomplished)}, d.Timeout(schema.TimeoutCreate), 0,
 		ecsService.SnapshotStateRefreshFunc(d.Id(), []string{string(SnapshotCreatingFailed)}))
 
 	if _, err := stateConf.WaitForState(); err != nil {
@@ -100,8 +106,8 @@ func resourceAliyunSnapshotRead(d *schema.ResourceData, meta interface{}) error
 	if err != nil && !NotFoundError(err) {
 		return WrapError(err)
 	}
-	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
+	if err := setResourceTags(client, d, tagsToMap(tags)); err != nil {
+		return WrapError(err)
 	}
 
 	return nil
@@ -139,14 +145,14 @@ func resourceAliyunSnapshotDelete(d *schema.ResourceData, meta interface{}) erro
 		return nil
 	})
 	if err != nil {
-		if IsExceptedError(err, SnapshotNotFound) {
+		if IsProductError(err, connectivity.ECSCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
 
-	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 0,
+	stateConf := BuildStateConf(client, []string{}, []string{}, d.Timeout(schema.TimeoutDelete), 0,
 		ecsService.SnapshotStateRefreshFunc(d.Id(), []string{string(SnapshotCreatingFailed)}))
 
 	if _, err = stateConf.WaitForState(); err != nil {
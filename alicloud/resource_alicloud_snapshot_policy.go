@@ -21,6 +21,11 @@ func resourceAliyunSnapshotPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -64,7 +69,7 @@ func resourceAliyunSnapshotPolicyCreate(d *schema.ResourceData, meta interface{}
 	d.SetId(response.AutoSnapshotPolicyId)
 
 	ecsService := EcsService{client}
-	if err := ecsService.WaitForSnapshotPolicy(d.Id(), SnapshotPolicyNormal, DefaultTimeout); err != nil {
+	if err := ecsService.WaitForSnapshotPolicy(d.Id(), SnapshotPolicyNormal, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -149,5 +154,5 @@ func resourceAliyunSnapshotPolicyDelete(d *schema.ResourceData, meta interface{}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(ecsService.WaitForSnapshotPolicy(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(ecsService.WaitForSnapshotPolicy(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -23,6 +23,12 @@ func resourceAliyunSnatEntry() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Update: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"snat_table_id": {
 				Type:     schema.TypeString,
@@ -75,7 +81,7 @@ func resourceAliyunSnatEntryCreate(d *schema.ResourceData, meta interface{}) err
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_snat_entry", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	if err := vpcService.WaitForSnatEntry(d.Id(), Available, DefaultTimeout); err != nil {
+	if err := vpcService.WaitForSnatEntry(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -134,7 +140,7 @@ func resourceAliyunSnatEntryUpdate(d *schema.ResourceData, meta interface{}) err
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 
-		if err := vpcService.WaitForSnatEntry(d.Id(), Available, DefaultTimeout); err != nil {
+		if err := vpcService.WaitForSnatEntry(d.Id(), Available, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -171,10 +177,10 @@ func resourceAliyunSnatEntryDelete(d *schema.ResourceData, meta interface{}) err
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidSnatTableIdNotFound, InvalidSnatEntryIdNotFound}) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForSnatEntry(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpcService.WaitForSnatEntry(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
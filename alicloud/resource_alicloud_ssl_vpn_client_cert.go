@@ -19,6 +19,11 @@ func resourceAliyunSslVpnClientCert() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"ssl_vpn_server_id": {
 				Type:     schema.TypeString,
@@ -51,12 +56,16 @@ func resourceAliyunSslVpnClientCertCreate(d *schema.ResourceData, meta interface
 	request.ClientToken = buildClientToken(request.GetActionName())
 
 	var response *vpc.CreateSslVpnClientCertResponse
+	resend := clientTokenWait(client)
 	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateSslVpnClientCert(&args)
 		})
 		if err != nil {
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
 			if IsExceptedError(err, VpnConfiguring) {
 				return resource.RetryableError(err)
 			}
@@ -73,7 +82,7 @@ func resourceAliyunSslVpnClientCertCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(response.SslVpnClientCertId)
 
-	err = vpnGatewayService.WaitForSslVpnClientCert(d.Id(), Ssl_Cert_Normal, DefaultTimeout)
+	err = vpnGatewayService.WaitForSslVpnClientCert(d.Id(), Ssl_Cert_Normal, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
@@ -142,10 +151,10 @@ func resourceAliyunSslVpnClientCertDelete(d *schema.ResourceData, meta interface
 		return nil
 	})
 	if err != nil {
-		if IsExceptedError(err, SslVpnClientCertNotFound) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpnGatewayService.WaitForSslVpnClientCert(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpnGatewayService.WaitForSslVpnClientCert(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -20,6 +20,11 @@ func resourceAliyunSslVpnServer() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"vpn_gateway_id": {
 				Type:     schema.TypeString,
@@ -103,13 +108,17 @@ func resourceAliyunSslVpnServerCreate(d *schema.ResourceData, meta interface{})
 	request.ClientToken = buildClientToken(request.GetActionName())
 
 	var response *vpc.CreateSslVpnServerResponse
+	wait := dependencyWait(client, 10*time.Second)
+	resend := clientTokenWait(client)
 	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateSslVpnServer(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, VpnConfiguring) {
-				time.Sleep(10 * time.Second)
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
+			if IsExceptedError(err, VpnConfiguring) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -123,7 +132,7 @@ func resourceAliyunSslVpnServerCreate(d *schema.ResourceData, meta interface{})
 	}
 
 	d.SetId(response.SslVpnServerId)
-	err = vpnGatewayService.WaitForSslVpnServer(d.Id(), Null, DefaultTimeout)
+	err = vpnGatewayService.WaitForSslVpnServer(d.Id(), Null, timeoutSeconds(d, schema.TimeoutCreate))
 
 	if err != nil {
 		return WrapError(err)
@@ -188,14 +197,14 @@ func resourceAliyunSslVpnServerUpdate(d *schema.ResourceData, meta interface{})
 		request.Compress = requests.NewBoolean(d.Get("compress").(bool))
 	}
 
+	wait := dependencyWait(client, 10*time.Second)
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ModifySslVpnServer(request)
 		})
 
 		if err != nil {
-			if IsExceptedError(err, VpnConfiguring) {
-				time.Sleep(10 * time.Second)
+			if IsExceptedError(err, VpnConfiguring) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -217,14 +226,14 @@ func resourceAliyunSslVpnServerDelete(d *schema.ResourceData, meta interface{})
 	request := vpc.CreateDeleteSslVpnServerRequest()
 	request.SslVpnServerId = d.Id()
 
+	wait := dependencyWait(client, 10*time.Second)
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteSslVpnServer(request)
 		})
 
 		if err != nil {
-			if IsExceptedError(err, VpnConfiguring) {
-				time.Sleep(10 * time.Second)
+			if IsExceptedError(err, VpnConfiguring) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -234,10 +243,10 @@ func resourceAliyunSslVpnServerDelete(d *schema.ResourceData, meta interface{})
 	})
 
 	if err != nil {
-		if IsExceptedError(err, SslVpnServerNotFound) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpnGatewayService.WaitForSslVpnServer(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpnGatewayService.WaitForSslVpnServer(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -21,6 +21,12 @@ func resourceAliyunVpc() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
@@ -68,6 +74,8 @@ func resourceAliyunVpc() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -78,17 +86,25 @@ func resourceAliyunVpcCreate(d *schema.ResourceData, meta interface{}) error {
 
 	var response *vpc.CreateVpcResponse
 	request := buildAliyunVpcArgs(d, meta)
+	wait := incrementalWait(client, 5*time.Second, 5*time.Second)
+	waitDependency := dependencyWait(client, 5*time.Second)
+	resend := clientTokenWait(client)
 	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVpc(&args)
 		})
 		if err != nil {
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
 			if IsExceptedError(err, VpcQuotaExceeded) {
 				return resource.NonRetryableError(WrapErrorf(err, "The number of VPC has quota has reached the quota limit in your account, and please use existing VPCs or remove some of them."))
 			}
-			if IsExceptedErrors(err, []string{TaskConflict, UnknownError, Throttling}) {
-				time.Sleep(5 * time.Second)
+			if IsThrottledError(err) && wait() {
+				return resource.RetryableError(err)
+			}
+			if IsExceptedErrors(err, []string{TaskConflict, UnknownError, Throttling}) && waitDependency() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -103,11 +119,16 @@ func resourceAliyunVpcCreate(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(response.VpcId)
 
-	err = vpcService.WaitForVpc(d.Id(), Available, DefaultTimeout)
+	err = vpcService.WaitForVpc(d.Id(), Available, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
 
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, VpcTagProduct, TagResourceVpc); err != nil {
+		return WrapError(err)
+	}
+
 	return resourceAliyunVpcRead(d, meta)
 }
 
@@ -130,6 +151,15 @@ func resourceAliyunVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("router_id", object.VRouterId)
 	d.Set("resource_group_id", object.ResourceGroupId)
 
+	tagService := TagService{client}
+	tags, err := tagService.DescribeTags(VpcTagProduct, TagResourceVpc, d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := setResourceTags(client, d, tags); err != nil {
+		return WrapError(err)
+	}
+
 	// Retrieve all route tables and filter to get system
 	request := vpc.CreateDescribeRouteTablesRequest()
 	request.RegionId = client.RegionId
@@ -140,13 +170,16 @@ func resourceAliyunVpcRead(d *schema.ResourceData, meta interface{}) error {
 	var routeTabls []vpc.RouteTable
 	for {
 		total := 0
+		wait := incrementalWait(client, 10*time.Second, 10*time.Second)
 		if err = resource.Retry(6*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 				return vpcClient.DescribeRouteTables(request)
 			})
-			if err != nil && IsExceptedErrors(err, []string{Throttling}) {
-				time.Sleep(10 * time.Second)
-				return resource.RetryableError(err)
+			if err != nil {
+				if IsThrottledError(err) && wait() {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
 			}
 			addDebug(request.GetActionName(), raw)
 			response, _ := raw.(*vpc.DescribeRouteTablesResponse)
@@ -180,6 +213,10 @@ func resourceAliyunVpcRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceAliyunVpcUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, VpcTagProduct, TagResourceVpc); err != nil {
+		return WrapError(err)
+	}
 
 	attributeUpdate := false
 	request := vpc.CreateModifyVpcAttributeRequest()
@@ -217,7 +254,7 @@ func resourceAliyunVpcDelete(d *schema.ResourceData, meta interface{}) error {
 			return vpcClient.DeleteVpc(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{InvalidVpcIDNotFound, ForbiddenVpcNotFound}) {
+			if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 				return nil
 			}
 			return resource.RetryableError(err)
@@ -228,7 +265,7 @@ func resourceAliyunVpcDelete(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForVpc(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpcService.WaitForVpc(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func buildAliyunVpcArgs(d *schema.ResourceData, meta interface{}) *vpc.CreateVpcRequest {
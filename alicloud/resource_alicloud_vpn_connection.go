@@ -23,6 +23,11 @@ func resourceAliyunVpnConnection() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"customer_gateway_id": {
 				Type:     schema.TypeString,
@@ -180,14 +185,18 @@ func resourceAliyunVpnConnectionCreate(d *schema.ResourceData, meta interface{})
 		return WrapError(err)
 	}
 	var response *vpc.CreateVpnConnectionResponse
+	wait := dependencyWait(client, 10*time.Second)
+	resend := clientTokenWait(client)
 	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVpnConnection(&args)
 		})
 		if err != nil {
-			if IsExceptedError(err, VpnConfiguring) {
-				time.Sleep(10 * time.Second)
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
+			if IsExceptedError(err, VpnConfiguring) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -202,7 +211,7 @@ func resourceAliyunVpnConnectionCreate(d *schema.ResourceData, meta interface{})
 
 	d.SetId(response.VpnConnectionId)
 
-	if err := vpnGatewayService.WaitForVpnConnection(d.Id(), Null, DefaultTimeoutMedium); err != nil {
+	if err := vpnGatewayService.WaitForVpnConnection(d.Id(), Null, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -298,6 +307,7 @@ func resourceAliyunVpnConnectionDelete(d *schema.ResourceData, meta interface{})
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.VpnConnectionId = d.Id()
 
+	wait := dependencyWait(client, 10*time.Second)
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
@@ -305,8 +315,7 @@ func resourceAliyunVpnConnectionDelete(d *schema.ResourceData, meta interface{})
 		})
 
 		if err != nil {
-			if IsExceptedError(err, VpnConfiguring) {
-				time.Sleep(10 * time.Second)
+			if IsExceptedError(err, VpnConfiguring) && wait() {
 				return resource.RetryableError(err)
 			}
 
@@ -316,12 +325,12 @@ func resourceAliyunVpnConnectionDelete(d *schema.ResourceData, meta interface{})
 		return nil
 	})
 	if err != nil {
-		if IsExceptedError(err, VpnConnNotFound) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpnGatewayService.WaitForVpnConnection(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpnGatewayService.WaitForVpnConnection(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func buildAliyunVpnConnectionArgs(d *schema.ResourceData, meta interface{}) (*vpc.CreateVpnConnectionRequest, error) {
@@ -19,6 +19,11 @@ func resourceAliyunVpnCustomerGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"ip_address": {
 				Type:         schema.TypeString,
@@ -55,17 +60,20 @@ func resourceAliyunVpnCustomerGatewayCreate(d *schema.ResourceData, meta interfa
 	}
 	request.ClientToken = buildClientToken(request.GetActionName())
 
-	wait := incrementalWait(3*time.Second, 5*time.Second)
+	wait := incrementalWait(client, 3*time.Second, 5*time.Second)
 	var raw interface{}
 	var err error
+	resend := clientTokenWait(client)
 	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateCustomerGateway(&args)
 		})
 		if err != nil {
-			if IsExceptedError(err, Throttling) {
-				wait()
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
+			if IsThrottledError(err) && wait() {
 				return resource.RetryableError(err)
 			}
 
@@ -82,7 +90,7 @@ func resourceAliyunVpnCustomerGatewayCreate(d *schema.ResourceData, meta interfa
 
 	d.SetId(response.CustomerGatewayId)
 
-	err = vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Null, 60)
+	err = vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Null, timeoutSeconds(d, schema.TimeoutCreate))
 	if err != nil {
 		return WrapError(err)
 	}
@@ -140,6 +148,7 @@ func resourceAliyunVpnCustomerGatewayDelete(d *schema.ResourceData, meta interfa
 	request := vpc.CreateDeleteCustomerGatewayRequest()
 	request.CustomerGatewayId = d.Id()
 	request.ClientToken = buildClientToken(request.GetActionName())
+	wait := dependencyWait(client, 10*time.Second)
 	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
@@ -147,8 +156,7 @@ func resourceAliyunVpnCustomerGatewayDelete(d *schema.ResourceData, meta interfa
 		})
 
 		if err != nil {
-			if IsExceptedError(err, VpnConfiguring) {
-				time.Sleep(10 * time.Second)
+			if IsExceptedError(err, VpnConfiguring) && wait() {
 				return resource.RetryableError(err)
 			}
 
@@ -159,10 +167,10 @@ func resourceAliyunVpnCustomerGatewayDelete(d *schema.ResourceData, meta interfa
 	})
 
 	if err != nil {
-		if IsExceptedError(err, CgwNotFound) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpnGatewayService.WaitForVpnCustomerGateway(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
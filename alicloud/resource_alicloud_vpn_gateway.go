@@ -23,6 +23,11 @@ func resourceAliyunVpnGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * DefaultTimeout * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -139,9 +144,12 @@ func resourceAliyunVpnGatewayCreate(d *schema.ResourceData, meta interface{}) er
 
 	request.AutoPay = requests.NewBoolean(true)
 
-	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-		return vpcClient.CreateVpnGateway(request)
-	})
+	// VPN gateways are created through an order and have no unique name.
+	raw, err := createWithClientToken(client, func() (interface{}, error) {
+		return client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.CreateVpnGateway(request)
+		})
+	}, nil)
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_vpn_gateway", request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -150,8 +158,10 @@ func resourceAliyunVpnGatewayCreate(d *schema.ResourceData, meta interface{}) er
 	response, _ := raw.(*vpc.CreateVpnGatewayResponse)
 	d.SetId(response.VpnGatewayId)
 
-	time.Sleep(10 * time.Second)
-	if err := vpnGatewayService.WaitForVpnGateway(d.Id(), Active, 2*DefaultTimeout); err != nil {
+	if err := sleepContext(client, 10*time.Second); err != nil {
+		return WrapError(err)
+	}
+	if err := vpnGatewayService.WaitForVpnGateway(d.Id(), Active, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
 		return WrapError(err)
 	}
 
@@ -262,14 +272,14 @@ func resourceAliyunVpnGatewayDelete(d *schema.ResourceData, meta interface{}) er
 	request := vpc.CreateDeleteVpnGatewayRequest()
 	request.VpnGatewayId = d.Id()
 	request.ClientToken = buildClientToken(request.GetActionName())
+	wait := dependencyWait(client, 10*time.Second)
 	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVpnGateway(&args)
 		})
 		if err != nil {
-			if IsExceptedError(err, VpnConfiguring) {
-				time.Sleep(10 * time.Second)
+			if IsExceptedError(err, VpnConfiguring) && wait() {
 				return resource.RetryableError(err)
 			}
 			/*Vpn known issue: while the vpn is configuring, it will return unknown error*/
@@ -283,11 +293,11 @@ func resourceAliyunVpnGatewayDelete(d *schema.ResourceData, meta interface{}) er
 	})
 
 	if err != nil {
-		if IsExceptedError(err, VpnNotFound) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 			return nil
 		}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	return WrapError(vpnGatewayService.WaitForVpnGateway(d.Id(), Deleted, DefaultTimeoutMedium))
+	return WrapError(vpnGatewayService.WaitForVpnGateway(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
@@ -18,6 +18,12 @@ func resourceAliyunSubnet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: setTagsAllDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(DefaultTimeoutMedium * time.Second),
+			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -44,6 +50,8 @@ func resourceAliyunSubnet() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -57,15 +65,22 @@ func resourceAliyunSwitchCreate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return WrapError(err)
 	}
+	wait := incrementalWait(client, 5*time.Second, 5*time.Second)
+	waitDependency := dependencyWait(client, 5*time.Second)
+	resend := clientTokenWait(client)
 	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVSwitch(&args)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{TaskConflict, UnknownError, InvalidStatusRouteEntry,
-				InvalidCidrBlockOverlapped, Throttling, TokenProcessing}) {
-				time.Sleep(5 * time.Second)
+			if resend(err) {
+				return resource.RetryableError(err)
+			}
+			if IsExceptedErrors(err, []string{Throttling, TokenProcessing}) && wait() {
+				return resource.RetryableError(err)
+			}
+			if IsExceptedErrors(err, []string{TaskConflict, UnknownError, InvalidStatusRouteEntry, InvalidCidrBlockOverlapped}) && waitDependency() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -78,7 +93,11 @@ func resourceAliyunSwitchCreate(d *schema.ResourceData, meta interface{}) error
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_vswitch", request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	d.SetId(vswitchID)
-	if err := vpcService.WaitForVSwitch(vswitchID, Available, DefaultTimeoutMedium); err != nil {
+	if err := vpcService.WaitForVSwitch(vswitchID, Available, timeoutSeconds(d, schema.TimeoutCreate)); err != nil {
+		return WrapError(err)
+	}
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, VpcTagProduct, TagResourceVSwitch); err != nil {
 		return WrapError(err)
 	}
 	return resourceAliyunSwitchRead(d, meta)
@@ -102,11 +121,20 @@ func resourceAliyunSwitchRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", vswitch.VSwitchName)
 	d.Set("description", vswitch.Description)
 
-	return nil
+	tagService := TagService{client}
+	tags, err := tagService.DescribeTags(VpcTagProduct, TagResourceVSwitch, d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	return WrapError(setResourceTags(client, d, tags))
 }
 
 func resourceAliyunSwitchUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
+	tagService := TagService{client}
+	if err := tagService.SetResourceTags(d, VpcTagProduct, TagResourceVSwitch); err != nil {
+		return WrapError(err)
+	}
 
 	update := false
 	request := vpc.CreateModifyVSwitchAttributeRequest()
@@ -146,7 +174,7 @@ func resourceAliyunSwitchDelete(d *schema.ResourceData, meta interface{}) error
 			if IsExceptedError(err, VswitcInvalidRegionId) {
 				return resource.NonRetryableError(err)
 			}
-			if IsExceptedError(err, InvalidVswitchIDNotFound) {
+			if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 				return nil
 			}
 
@@ -158,7 +186,7 @@ func resourceAliyunSwitchDelete(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
-	return WrapError(vpcService.WaitForVSwitch(d.Id(), Deleted, DefaultTimeout))
+	return WrapError(vpcService.WaitForVSwitch(d.Id(), Deleted, timeoutSeconds(d, schema.TimeoutDelete)))
 }
 
 func buildAliyunSwitchArgs(d *schema.ResourceData, meta interface{}) (*vpc.CreateVSwitchRequest, error) {
@@ -62,7 +62,7 @@ func testSweepVSwitches(region string) error {
 	// If there is no vpc_id, setting PageSizeSmall can avoid ServiceUnavailable Error
 	req.PageSize = requests.NewInteger(PageSizeSmall)
 	req.PageNumber = requests.NewInteger(1)
-	invoker := NewInvoker()
+	invoker := NewInvoker(client)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
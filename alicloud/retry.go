@@ -0,0 +1,47 @@
+package alicloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// isTransientError reports whether the error is a throttling or a transient failure of the service or the network.
+// Only the retries of these errors are charged against the retry budget and limited by max_retries and
+// max_retry_timeout, and the others just wait for a dependency up to the retry count of their catcher.
+func isTransientError(err error) bool {
+	return IsThrottledError(err) || IsRetryableError(err) || IsAmbiguousError(err)
+}
+
+// sleepContext sleeps like time.Sleep, but returns connectivity.ErrStopped at once when the provider of the client is
+// stopped.
+func sleepContext(client *connectivity.AliyunClient, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-client.StopContext().Done():
+		return connectivity.ErrStopped
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retryRefreshFunc retries the throttling and service busy errors of a state refresh function with the Invoker,
+// so a waiter does not fail because of a transient error. It fails at once when the provider is stopped.
+func retryRefreshFunc(client *connectivity.AliyunClient, f resource.StateRefreshFunc) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		if client.StopContext().Err() != nil {
+			return nil, "", connectivity.ErrStopped
+		}
+		var object interface{}
+		var status string
+		invoker := NewInvoker(client)
+		err := invoker.Run(func() error {
+			var err error
+			object, status, err = f()
+			return err
+		})
+		return object, status, err
+	}
+}
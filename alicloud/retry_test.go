@@ -0,0 +1,206 @@
+package alicloud
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func TestInvokerRun(t *testing.T) {
+	invoker := Invoker{}
+	invoker.AddCatcher(Catcher{Throttling, 2, 0})
+
+	calls := 0
+	err := invoker.Run(func() error {
+		calls++
+		return errors.NewServerError(400, `{"Code": "Throttling"}`, "")
+	})
+	if err == nil || calls != 3 {
+		t.Fatalf("the throttling error is expected to be retried twice, got %d calls and error %v", calls, err)
+	}
+
+	calls = 0
+	err = invoker.Run(func() error {
+		calls++
+		if calls < 3 {
+			return errors.NewServerError(400, `{"Code": "Throttling"}`, "")
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Fatalf("the catcher retry count is expected not to leak across runs, got %d calls and error %v", calls, err)
+	}
+}
+
+// newTestClient returns a client which is built without any API call, with the retry limits and the stop context.
+func newTestClient(t *testing.T, maxRetries int, stopContext context.Context) *connectivity.AliyunClient {
+	config := &connectivity.Config{
+		RegionId:                  "cn-hangzhou",
+		SkipRegionValidation:      true,
+		SkipCredentialsValidation: true,
+		MaxRetries:                maxRetries,
+		StopContext:               stopContext,
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("building the client got an error: %s", err)
+	}
+	return client
+}
+
+// leaveRetryBudget spends the retry budget of the client but the given retries.
+func leaveRetryBudget(client *connectivity.AliyunClient, retries int) {
+	engine := client.RetryEngine()
+	for engine.Allow(10, 1, time.Now(), 0) {
+	}
+	for i := 0; i < retries; i++ {
+		engine.Succeed()
+	}
+}
+
+func TestInvokerRunBudget(t *testing.T) {
+	client := newTestClient(t, 1, context.Background())
+	leaveRetryBudget(client, 0)
+
+	invoker := Invoker{client: client}
+	invoker.AddCatcher(Catcher{string(ErrorConflict), 3, 0})
+	calls := 0
+	err := invoker.Run(func() error {
+		calls++
+		if calls < 4 {
+			return errors.NewServerError(400, `{"Code": "IncorrectInstanceStatus"}`, "")
+		}
+		return nil
+	})
+	if err != nil || calls != 4 {
+		t.Fatalf("the conflict error is expected to be retried without max_retries and budget, got %d calls and error %v", calls, err)
+	}
+
+	leaveRetryBudget(client, 1)
+	invoker = Invoker{client: client}
+	invoker.AddCatcher(Catcher{Throttling, 3, 0})
+	calls = 0
+	err = invoker.Run(func() error {
+		calls++
+		return errors.NewServerError(400, `{"Code": "Throttling"}`, "")
+	})
+	if err == nil || calls != 2 {
+		t.Fatalf("the throttling error is expected to be retried once by max_retries, got %d calls and error %v", calls, err)
+	}
+	if client.RetryEngine().Allow(10, 1, time.Now(), 0) {
+		t.Fatalf("the throttling retry is expected to be charged against the budget")
+	}
+
+	other := newTestClient(t, 1, context.Background())
+	if !other.RetryEngine().Allow(10, 1, time.Now(), 0) {
+		t.Fatalf("the budget is expected not to be shared by the clients of two providers")
+	}
+}
+
+func TestDependencyWait(t *testing.T) {
+	client := newTestClient(t, 1, context.Background())
+	leaveRetryBudget(client, 1)
+
+	wait := dependencyWait(client, 0)
+	for i := 0; i < 3; i++ {
+		if !wait() {
+			t.Fatalf("the dependency wait is expected not to be limited by the provider retry limits")
+		}
+	}
+	if !client.RetryEngine().Allow(10, 1, time.Now(), 0) {
+		t.Fatalf("the dependency wait is expected not to spend the budget")
+	}
+}
+
+func TestInvokerRunStopped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client := newTestClient(t, 0, ctx)
+
+	invoker := Invoker{client: client}
+	invoker.AddCatcher(Catcher{Throttling, 10, 60})
+	calls := 0
+	start := time.Now()
+	err := invoker.Run(func() error {
+		calls++
+		return errors.NewServerError(400, `{"Code": "Throttling"}`, "")
+	})
+	if err != connectivity.ErrStopped || calls != 1 || time.Since(start) > time.Second {
+		t.Fatalf("the retry is expected to stop at once, got %d calls and error %v", calls, err)
+	}
+
+	if incrementalWait(client, time.Minute, time.Minute)() {
+		t.Fatalf("the incremental wait is expected to stop at once")
+	}
+	if dependencyWait(client, time.Minute)() {
+		t.Fatalf("the dependency wait is expected to stop at once")
+	}
+}
+
+func TestCreateWithClientToken(t *testing.T) {
+	defer func(catcher Catcher) {
+		ClientTokenCatcher = catcher
+	}(ClientTokenCatcher)
+	ClientTokenCatcher.RetryWaitSeconds = 0
+	timeout := errors.NewClientError(errors.TimeoutErrorCode, "timeout", nil)
+
+	calls := 0
+	raw, err := createWithClientToken(nil, func() (interface{}, error) {
+		calls++
+		if calls < 3 {
+			return nil, timeout
+		}
+		return "created", nil
+	}, nil)
+	if err != nil || raw != "created" || calls != 3 {
+		t.Fatalf("the request is expected to be resent after the timeouts, got %d calls, %v and error %v", calls, raw, err)
+	}
+
+	calls = 0
+	_, err = createWithClientToken(nil, func() (interface{}, error) {
+		calls++
+		return nil, errors.NewServerError(400, `{"Code": "InvalidParameter"}`, "")
+	}, func() (interface{}, error) {
+		t.Fatalf("the resource is expected not to be looked up after a server error")
+		return nil, nil
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("the request is expected not to be resent after a server error, got %d calls and error %v", calls, err)
+	}
+
+	calls = 0
+	raw, err = createWithClientToken(nil, func() (interface{}, error) {
+		calls++
+		return nil, timeout
+	}, func() (interface{}, error) {
+		return "found", nil
+	})
+	if err != nil || raw != "found" || calls != ClientTokenCatcher.RetryCount+1 {
+		t.Fatalf("the resource is expected to be looked up after the resends, got %d calls, %v and error %v", calls, raw, err)
+	}
+
+	_, err = createWithClientToken(nil, func() (interface{}, error) {
+		return nil, timeout
+	}, func() (interface{}, error) {
+		return nil, nil
+	})
+	if err == nil {
+		t.Fatalf("the timeout is expected to be returned when the resource is not found")
+	}
+
+	resend := clientTokenWait(nil)
+	if resend(errors.NewServerError(400, `{"Code": "InvalidParameter"}`, "")) {
+		t.Fatalf("the request is expected not to be resent after a server error")
+	}
+	for i := 0; i < ClientTokenCatcher.RetryCount; i++ {
+		if !resend(timeout) {
+			t.Fatalf("the request is expected to be resent after the timeout %d", i+1)
+		}
+	}
+	if resend(timeout) {
+		t.Fatalf("the request is expected not to be resent after %d timeouts", ClientTokenCatcher.RetryCount)
+	}
+}
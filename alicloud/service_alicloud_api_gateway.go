@@ -22,7 +22,7 @@ func (s *CloudApiService) DescribeApiGatewayGroup(id string) (apiGroup *cloudapi
 		return cloudApiClient.DescribeApiGroup(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, ApiGroupNotFound) {
+		if IsProductError(err, connectivity.CLOUDAPICode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -65,7 +65,7 @@ func (s *CloudApiService) DescribeApiGatewayApp(id string) (app *cloudapi.Descri
 		return cloudApiClient.DescribeApp(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, NotFoundApp) {
+		if IsProductError(err, connectivity.CLOUDAPICode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -110,7 +110,7 @@ func (s *CloudApiService) DescribeApiGatewayApi(id string) (api *cloudapi.Descri
 		return cloudApiClient.DescribeApi(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{ApiGroupNotFound, ApiNotFound}) {
+		if IsProductError(err, connectivity.CLOUDAPICode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -164,7 +164,7 @@ func (s *CloudApiService) DescribeApiGatewayAppAttachment(id string) (*cloudapi.
 			return cloudApiClient.DescribeAuthorizedApps(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ApiGroupNotFound, ApiNotFound}) {
+			if IsProductError(err, connectivity.CLOUDAPICode, ErrorNotFound) {
 				return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
 			return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -268,7 +268,9 @@ func (s *CloudApiService) WaitForApiGatewayAppAttachment(id string, status Statu
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, strconv.Itoa(object.AppId), appIds, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -286,7 +288,7 @@ func (s *CloudApiService) DescribeDeployedApi(id string, stageName string) (api
 		return cloudApiClient.DescribeDeployedApi(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{ApiGroupNotFound, ApiNotFound, NotFoundStage}) {
+		if IsProductError(err, connectivity.CLOUDAPICode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -332,7 +334,7 @@ func (s *CloudApiService) AbolishApi(id string, stageName string) (err error) {
 	})
 
 	if err != nil {
-		if IsExceptedErrors(err, []string{ApiGroupNotFound, ApiNotFound, NotFoundStage}) {
+		if IsProductError(err, connectivity.CLOUDAPICode, ErrorNotFound) {
 			return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -21,7 +21,7 @@ func (c *CdnService) DescribeCdnDomainNew(id string) (*cdn.GetDomainDetailModel,
 	})
 
 	if err != nil {
-		if IsExceptedError(err, InvalidDomainNotFound) {
+		if IsProductError(err, connectivity.CDNCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -46,7 +46,7 @@ func (c *CdnService) DescribeCdnDomainConfig(id string) (*cdn.DomainConfig, erro
 		return cdnClient.DescribeCdnDomainConfigs(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, InvalidDomainNotFound) {
+		if IsProductError(err, connectivity.CDNCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -64,7 +64,9 @@ func (c *CdnService) DescribeCdnDomainConfig(id string) (*cdn.DomainConfig, erro
 
 func (c *CdnService) WaitForCdnDomain(id string, status Status, timeout int) error {
 	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
-	time.Sleep(DefaultIntervalShort * time.Second)
+	if err := sleepContext(c.client, DefaultIntervalShort*time.Second); err != nil {
+		return WrapError(err)
+	}
 
 	for {
 		domain, err := c.DescribeCdnDomainNew(id)
@@ -77,7 +79,9 @@ func (c *CdnService) WaitForCdnDomain(id string, status Status, timeout int) err
 		if domain.DomainStatus == string(status) {
 			break
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(c.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, domain.DomainStatus, status, ProviderERROR)
 		}
@@ -117,7 +121,9 @@ func (c *CdnService) WaitForServerCertificateNew(id string, serverCertificate st
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, strings.TrimSpace(certInfo.ServerCertificate), strings.TrimSpace(serverCertificate), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(c.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
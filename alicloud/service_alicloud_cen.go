@@ -34,13 +34,13 @@ func (s *CenService) DescribeCenInstance(id string) (c cbn.Cen, err error) {
 	request.Filter = &filters
 
 	var raw interface{}
+	wait := incrementalWait(s.client, 10*time.Second, 10*time.Second)
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
 			return cbnClient.DescribeCens(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, CenThrottlingUser}) {
-				time.Sleep(10 * time.Second)
+			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, CenThrottlingUser}) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -49,7 +49,7 @@ func (s *CenService) DescribeCenInstance(id string) (c cbn.Cen, err error) {
 		return nil
 	})
 	if err != nil {
-		if IsExceptedError(err, ParameterCenInstanceIdNotExist) {
+		if IsProductError(err, connectivity.CENCode, ErrorNotFound) {
 			return c, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return c, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -98,7 +98,7 @@ func (s *CenService) DescribeCenInstanceAttachment(id string) (c *cbn.ChildInsta
 		})
 
 		if err != nil {
-			if IsExceptedError(err, ParameterInstanceIdNotExist) {
+			if IsProductError(err, connectivity.CENCode, ErrorNotFound) {
 				return nil, WrapErrorf(Error(GetNotFoundMessage("CEN Instance Attachment", instanceId)), NotFoundMsg, ProviderERROR)
 			}
 			return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), ProviderERROR)
@@ -138,7 +138,9 @@ func (s *CenService) WaitForCenInstanceAttachment(id string, status Status, time
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, status, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	return nil
@@ -155,13 +157,13 @@ func (s *CenService) DescribeCenBandwidthPackage(id string) (c cbn.CenBandwidthP
 	request.Filter = &filters
 
 	var raw interface{}
+	wait := incrementalWait(s.client, 10*time.Second, 10*time.Second)
 	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
 			return cbnClient.DescribeCenBandwidthPackages(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, CenThrottlingUser}) {
-				time.Sleep(10 * time.Second)
+			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, CenThrottlingUser}) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -170,7 +172,7 @@ func (s *CenService) DescribeCenBandwidthPackage(id string) (c cbn.CenBandwidthP
 		return nil
 	})
 	if err != nil {
-		if IsExceptedError(err, ParameterCenInstanceIdNotExist) {
+		if IsProductError(err, connectivity.CENCode, ErrorNotFound) {
 			return c, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return c, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -204,7 +206,9 @@ func (s *CenService) WaitForCenBandwidthPackage(id string, status Status, bandwi
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, status, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -241,7 +245,9 @@ func (s *CenService) WaitForCenBandwidthPackageAttachment(id string, status Stat
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, status, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	return nil
@@ -373,13 +379,13 @@ func (s *CenService) DescribeCenRouteEntry(id string) (c cbn.PublishedRouteEntry
 	request.DestinationCidrBlock = cidr
 
 	var raw interface{}
+	wait := incrementalWait(s.client, 10*time.Second, 10*time.Second)
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithCenClient(func(cbnClient *cbn.Client) (interface{}, error) {
 			return cbnClient.DescribePublishedRouteEntries(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, CenThrottlingUser}) {
-				time.Sleep(10 * time.Second)
+			if IsExceptedErrors(err, []string{AliyunGoClientFailure, "ServiceUnavailable", Throttling, CenThrottlingUser}) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -388,7 +394,7 @@ func (s *CenService) DescribeCenRouteEntry(id string) (c cbn.PublishedRouteEntry
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{ParameterIllegal, ParameterIllegalCenInstanceId, InstanceNotExist}) {
+		if IsProductError(err, connectivity.CENCode, ErrorNotFound) || IsExceptedError(err, ParameterIllegal) {
 			return c, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return c, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -425,7 +431,9 @@ func (s *CenService) WaitForCenRouterEntry(id string, status Status, timeout int
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.PublishStatus, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	return nil
@@ -67,7 +67,9 @@ func (s *CmsService) WaitForCmsAlarm(id string, enabled bool, timeout int) error
 		if timeout <= 0 {
 			return GetTimeErrorFromString(GetTimeoutMessage("Alarm", strconv.FormatBool(enabled)))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
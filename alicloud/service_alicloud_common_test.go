@@ -329,6 +329,41 @@ func (ra *resourceAttr) resourceAttrMapUpdateSet() resourceAttrMapUpdate {
 	}
 }
 
+// get the import ID which joins the attribute values of the resource in the state with a colon
+func importStateIdFromAttributes(resourceId string, keys ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceId]
+		if !ok {
+			return "", WrapError(fmt.Errorf("resource %s is not found in the state", resourceId))
+		}
+		var parts []string
+		for _, key := range keys {
+			parts = append(parts, rs.Primary.Attributes[key])
+		}
+		return strings.Join(parts, COLON_SEPARATED), nil
+	}
+}
+
+// check the imported resource has the expected attributes, and it is used when the resource ID is generated by the
+// provider and cannot be verified by ImportStateVerify
+func importStateCheckAttributes(checkMap map[string]string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return WrapError(fmt.Errorf("expected 1 imported state, got %d", len(states)))
+		}
+		for key, value := range checkMap {
+			got := states[0].Attributes[key]
+			if value == CHECKSET && got != "" {
+				continue
+			}
+			if got != value {
+				return WrapError(fmt.Errorf("expected the imported %s to be %s, got %s", key, value, got))
+			}
+		}
+		return nil
+	}
+}
+
 func resourceTestAccConfigFunc(resourceId string,
 	name string,
 	configDependence func(name string) string) ResourceTestAccConfigFunc {
@@ -140,7 +140,7 @@ type crTag struct {
 }
 
 func (c *CrService) DescribeNamespace(namespaceName string) (*cr.GetNamespaceResponse, error) {
-	invoker := NewInvoker()
+	invoker := NewInvoker(c.client)
 
 	req := cr.CreateGetNamespaceRequest()
 	req.Namespace = namespaceName
@@ -155,7 +155,7 @@ func (c *CrService) DescribeNamespace(namespaceName string) (*cr.GetNamespaceRes
 		resp, _ = raw.(*cr.GetNamespaceResponse)
 		return err
 	}); err != nil {
-		if IsExceptedError(err, ErrorNamespaceNotExist) {
+		if IsProductError(err, connectivity.CRCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, namespaceName, req.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -177,7 +177,7 @@ func (c *CrService) DescribeCrRepo(id string) (*cr.GetRepoResponse, error) {
 	})
 	response, _ := raw.(*cr.GetRepoResponse)
 	if err != nil {
-		if IsExceptedError(err, ErrorRepoNotExist) {
+		if IsProductError(err, connectivity.CRCode, ErrorNotFound) {
 			return response, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -15,7 +15,7 @@ type CsService struct {
 
 func (s *CsService) GetContainerClusterByName(name string) (cluster cs.ClusterType, err error) {
 	name = Trim(name)
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	var clusters []cs.ClusterType
 	err = invoker.Run(func() error {
 		raw, e := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
@@ -50,7 +50,7 @@ func (s *CsService) GetContainerClusterAndCertsByName(name string) (*cs.ClusterT
 		return nil, nil, err
 	}
 	var certs cs.ClusterCerts
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, e := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 			return csClient.GetClusterCerts(cluster.ClusterID)
@@ -80,7 +80,7 @@ func (s *CsService) DescribeContainerApplication(clusterName, appName string) (a
 	})
 	app, _ = raw.(cs.GetProjectResponse)
 	if err != nil {
-		if IsExceptedError(err, ApplicationNotFound) {
+		if IsProductError(err, connectivity.CONTAINCode, ErrorNotFound) || IsExceptedError(err, ApplicationNotFound) {
 			return app, GetNotFoundErrorFromString(GetNotFoundMessage("Container Application", appName))
 		}
 		return app, fmt.Errorf("Getting Application failed by name %s: %#v.", appName, err)
@@ -109,7 +109,9 @@ func (s *CsService) WaitForContainerApplication(clusterName, appName string, sta
 		if timeout <= 0 {
 			return GetTimeErrorFromString(fmt.Sprintf("Waitting for container application %s is timeout and current status is %s.", string(status), app.CurrentState))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
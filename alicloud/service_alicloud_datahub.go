@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
 func convUint64ToDate(t uint64) string {
@@ -40,7 +41,7 @@ const (
 )
 
 func isDatahubNotExistError(err error) bool {
-	return IsExceptedErrors(err, []string{datahub.NoSuchProject, datahub.NoSuchTopic, datahub.NoSuchShard, datahub.NoSuchSubscription, DoesNotExist})
+	return IsProductError(err, connectivity.DATAHUBCode, ErrorNotFound) || IsExceptedError(err, DoesNotExist)
 }
 
 func isTerraformTestingDatahubObject(name string) bool {
@@ -19,14 +19,14 @@ func (s *DdoscooService) DescribeDdoscooInstance(id string) (v ddoscoo.Instance,
 	request.PageNo = "1"
 	request.PageSize = "10"
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithDdoscooClient(func(ddoscooClient *ddoscoo.Client) (interface{}, error) {
 			return ddoscooClient.DescribeInstances(request)
 		})
 
 		if err != nil {
-			if IsExceptedErrors(err, []string{DdoscooInstanceNotFound}) {
+			if IsProductError(err, connectivity.DDOSCOOCode, ErrorNotFound) {
 				return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
 
@@ -49,14 +49,14 @@ func (s *DdoscooService) DescribeDdoscooInstanceSpec(id string) (v ddoscoo.Insta
 	request := ddoscoo.CreateDescribeInstanceSpecsRequest()
 	request.InstanceIds = "[\"" + id + "\"]"
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithDdoscooClient(func(ddoscooClient *ddoscoo.Client) (interface{}, error) {
 			return ddoscooClient.DescribeInstanceSpecs(request)
 		})
 
 		if err != nil {
-			if IsExceptedErrors(err, []string{DdoscooInstanceNotFound, InvalidDdoscooInstance}) {
+			if IsProductError(err, connectivity.DDOSCOOCode, ErrorNotFound) {
 				return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
 
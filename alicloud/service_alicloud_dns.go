@@ -18,7 +18,7 @@ func (s *DnsService) DescribeDns(id string) (*alidns.DescribeDomainInfoResponse,
 		return dnsClient.DescribeDomainInfo(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, InvalidDomainNameNoExist) {
+		if IsProductError(err, connectivity.DNSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -72,7 +72,7 @@ func (dns *DnsService) DescribeDnsRecord(id string) (*alidns.DescribeDomainRecor
 		return dnsClient.DescribeDomainRecordInfo(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{DomainRecordNotBelongToUser}) {
+		if IsProductError(err, connectivity.DNSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -19,7 +19,7 @@ func (s *DrdsService) DescribeDrdsInstance(id string) (response *drds.DescribeDr
 	})
 
 	if err != nil {
-		if IsExceptedError(err, InvalidDRDSInstanceIdNotFound) {
+		if IsProductError(err, connectivity.DRDSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
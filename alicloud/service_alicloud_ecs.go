@@ -20,25 +20,10 @@ type EcsService struct {
 }
 
 func (s *EcsService) JudgeRegionValidation(key, region string) error {
-	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-		return ecsClient.DescribeRegions(ecs.CreateDescribeRegionsRequest())
-	})
-	if err != nil {
-		return fmt.Errorf("DescribeRegions got an error: %#v", err)
-	}
-	resp, _ := raw.(*ecs.DescribeRegionsResponse)
-	if resp == nil || len(resp.Regions.Region) < 1 {
-		return GetNotFoundErrorFromString("There is no any available region.")
-	}
-
-	var rs []string
-	for _, v := range resp.Regions.Region {
-		if v.RegionId == region {
-			return nil
-		}
-		rs = append(rs, v.RegionId)
+	if s.client.IsRegionSupported(connectivity.ECSCode, region) {
+		return nil
 	}
-	return fmt.Errorf("'%s' is invalid. Expected on %v.", key, strings.Join(rs, ", "))
+	return fmt.Errorf("'%s' is invalid. Expected on %v.", key, strings.Join(s.client.DescribeRegionIds(connectivity.ECSCode), ", "))
 }
 
 // DescribeZone validate zoneId is valid in region
@@ -68,6 +53,10 @@ func (s *EcsService) DescribeZone(id string) (zone ecs.Zone, err error) {
 }
 
 func (s *EcsService) DescribeInstance(id string) (instance ecs.Instance, err error) {
+	if object, found, ok := batchDescribe(s.client, ecsInstanceDescribeKind, id); ok && found {
+		return object.(ecs.Instance), nil
+	}
+
 	request := ecs.CreateDescribeInstancesRequest()
 	request.InstanceIds = convertListToJsonString([]interface{}{id})
 
@@ -107,6 +96,10 @@ func (s *EcsService) DescribeInstanceAttribute(id string) (instance ecs.Describe
 }
 
 func (s *EcsService) QueryInstanceSystemDisk(id string) (disk ecs.Disk, err error) {
+	if object, found, ok := batchDescribe(s.client, ecsSystemDiskDescribeKind, id); ok && found {
+		return object.(ecs.Disk), nil
+	}
+
 	request := ecs.CreateDescribeDisksRequest()
 	request.InstanceId = id
 	request.DiskType = string(DiskTypeSystem)
@@ -169,7 +162,7 @@ func (s *EcsService) LeaveSecurityGroups(instanceId string, securityGroupIds []s
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.LeaveSecurityGroup(request)
 		})
-		if err != nil && IsExceptedErrors(err, []string{InvalidSecurityGroupIdNotFound}) {
+		if err != nil && IsProductError(err, connectivity.ECSCode, ErrorNotFound) {
 			return WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw)
@@ -186,7 +179,7 @@ func (s *EcsService) DescribeSecurityGroup(id string) (group ecs.DescribeSecurit
 		return ecsClient.DescribeSecurityGroupAttribute(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidSecurityGroupIdNotFound}) {
+		if IsProductError(err, connectivity.ECSCode, ErrorNotFound) {
 			err = WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return
@@ -441,6 +434,10 @@ func (s *EcsService) DescribeKeyPairAttachment(id string) (keyPair ecs.KeyPair,
 }
 
 func (s *EcsService) DescribeDisk(id string) (disk ecs.Disk, err error) {
+	if object, found, ok := batchDescribe(s.client, ecsDiskDescribeKind, id); ok && found {
+		return object.(ecs.Disk), nil
+	}
+
 	request := ecs.CreateDescribeDisksRequest()
 	request.DiskIds = convertListToJsonString([]interface{}{id})
 
@@ -532,6 +529,127 @@ func (s *EcsService) DescribeImageById(id string) (image ecs.Image, err error) {
 	return resp.Images.Image[0], nil
 }
 
+// DescribeImage returns the image in any status, while DescribeImages returns the available images only by default.
+func (s *EcsService) DescribeImage(id string) (image ecs.Image, err error) {
+	request := ecs.CreateDescribeImagesRequest()
+	request.RegionId = s.client.RegionId
+	request.ImageId = id
+	request.Status = strings.Join([]string{string(ImageCreating), string(ImageWaiting), string(ImageAvailable),
+		string(ImageUnAvailable), string(ImageCreateFailed)}, ",")
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeImages(request)
+	})
+	if err != nil {
+		return image, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw)
+	response, _ := raw.(*ecs.DescribeImagesResponse)
+	if len(response.Images.Image) < 1 || response.Images.Image[0].ImageId != id {
+		return image, WrapErrorf(GetNotFoundErrorFromString(GetNotFoundMessage("Image", id)), NotFoundMsg, ProviderERROR)
+	}
+	return response.Images.Image[0], nil
+}
+
+func (s *EcsService) ImageStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeImage(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object.Status == failState {
+				return object, object.Status, WrapError(Error(FailedToReachTargetStatus, object.Status))
+			}
+		}
+		return object, object.Status, nil
+	}
+}
+
+// DescribeImageSharePermission returns the account with which the image is shared. The id is the image ID and the
+// account ID.
+func (s *EcsService) DescribeImageSharePermission(id string) (account ecs.Account, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return account, WrapError(err)
+	}
+	request := ecs.CreateDescribeImageSharePermissionRequest()
+	request.RegionId = s.client.RegionId
+	request.ImageId = parts[0]
+	request.PageSize = requests.NewInteger(PageSizeXLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.DescribeImageSharePermission(request)
+		})
+		if err != nil {
+			if IsProductError(err, connectivity.ECSCode, ErrorNotFound) {
+				return account, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
+			}
+			return account, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw)
+		response, _ := raw.(*ecs.DescribeImageSharePermissionResponse)
+		for _, a := range response.Accounts.Account {
+			if a.AliyunId == parts[1] {
+				return a, nil
+			}
+		}
+		if len(response.Accounts.Account) < PageSizeXLarge {
+			break
+		}
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return account, WrapError(err)
+		} else {
+			request.PageNumber = page
+		}
+	}
+	return account, WrapErrorf(GetNotFoundErrorFromString(GetNotFoundMessage("ImageSharePermission", id)), NotFoundMsg, ProviderERROR)
+}
+
+// DescribeTask returns the asynchronous task of ECS, like exporting an image.
+func (s *EcsService) DescribeTask(id string) (task ecs.Task, err error) {
+	request := ecs.CreateDescribeTasksRequest()
+	request.RegionId = s.client.RegionId
+	request.TaskIds = id
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeTasks(request)
+	})
+	if err != nil {
+		return task, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw)
+	response, _ := raw.(*ecs.DescribeTasksResponse)
+	if len(response.TaskSet.Task) < 1 || response.TaskSet.Task[0].TaskId != id {
+		return task, WrapErrorf(GetNotFoundErrorFromString(GetNotFoundMessage("Task", id)), NotFoundMsg, ProviderERROR)
+	}
+	return response.TaskSet.Task[0], nil
+}
+
+func (s *EcsService) TaskStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeTask(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object.TaskStatus == failState {
+				return object, object.TaskStatus, WrapError(Error(FailedToReachTargetStatus, object.TaskStatus))
+			}
+		}
+		return object, object.TaskStatus, nil
+	}
+}
+
 func (s *EcsService) DescribeNetworkInterface(id string) (networkInterface ecs.NetworkInterfaceSet, err error) {
 	request := ecs.CreateDescribeNetworkInterfacesRequest()
 	eniIds := []string{id}
@@ -595,14 +713,18 @@ func (s *EcsService) WaitForEcsInstance(instanceId string, status Status, timeou
 		}
 		if instance.Status == string(status) {
 			//Sleep one more time for timing issues
-			time.Sleep(DefaultIntervalMedium * time.Second)
+			if err := sleepContext(s.client, DefaultIntervalMedium*time.Second); err != nil {
+				return WrapError(err)
+			}
 			break
 		}
 		timeout = timeout - DefaultIntervalShort
 		if timeout <= 0 {
 			return GetTimeErrorFromString(GetTimeoutMessage("ECS Instance", string(status)))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 
 	}
 	return nil
@@ -766,7 +888,9 @@ func (s *EcsService) WaitForVpcAttributesChanged(instanceId, vswitchId, privateI
 		if time.Now().After(deadline) {
 			return WrapError(Error("Wait for VPC attributes changed timeout"))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 
 		instance, err := s.DescribeInstance(instanceId)
 		if err != nil {
@@ -791,7 +915,9 @@ func (s *EcsService) WaitForPrivateIpsCountChanged(eniId string, count int) erro
 		if time.Now().After(deadline) {
 			return fmt.Errorf("Wait for private IP addrsses count changed timeout")
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 
 		ips, err := s.QueryPrivateIps(eniId)
 		if err != nil {
@@ -809,7 +935,9 @@ func (s *EcsService) WaitForPrivateIpsListChanged(eniId string, ipList []string)
 		if time.Now().After(deadline) {
 			return fmt.Errorf("Wait for private IP addrsses list changed timeout")
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 
 		ips, err := s.QueryPrivateIps(eniId)
 		if err != nil {
@@ -979,7 +1107,9 @@ func (s *EcsService) WaitForSnapshotPolicy(id string, status Status, timeout int
 		if time.Now().After(deadLine) {
 			return WrapErrorf(GetTimeErrorFromString("ECS WaitForSnapshotPolicy"), WaitTimeoutMsg, id, GetFunc(1), timeout, snapshotPolicy.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -1018,7 +1148,7 @@ func (s *EcsService) DescribeLaunchTemplateVersion(id string, version int) (set
 		return ecsClient.DescribeLaunchTemplateVersions(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, "InvalidLaunchTemplate.NotFound") {
+		if IsProductError(err, connectivity.ECSCode, ErrorNotFound) {
 			err = WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			return
 		}
@@ -1057,6 +1187,8 @@ func (s *EcsService) WaitForLaunchTemplate(id string, status Status, timeout int
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, Null, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
@@ -227,7 +227,7 @@ func (s *EssService) DescribeEssScalingRule(id string) (rule ess.ScalingRule, er
 		return essClient.DescribeScalingRules(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidScalingRuleIdNotFound}) {
+		if IsProductError(err, connectivity.ESSCode, ErrorNotFound) {
 			return rule, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return rule, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -307,7 +307,9 @@ func (s *EssService) WaitForEssScheduledTask(id string, status Status, timeout i
 			return nil
 		}
 
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.ScheduledTaskId, id, ProviderERROR)
 		}
@@ -330,7 +332,7 @@ func (srv *EssService) DescribeEssAttachment(id string, instanceIds []string) (i
 		return essClient.DescribeScalingInstances(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidScalingGroupIdNotFound}) {
+		if IsProductError(err, connectivity.ESSCode, ErrorNotFound) {
 			err = WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		} else {
 			err = WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -383,7 +385,7 @@ func (s *EssService) DescribeEssScalingConfifurations(id string) (configs []ess.
 	return
 }
 
-func (srv *EssService) EssRemoveInstances(id string, instanceIds []string) error {
+func (srv *EssService) EssRemoveInstances(id string, instanceIds []string, timeout int) error {
 
 	if len(instanceIds) < 1 {
 		return nil
@@ -397,7 +399,7 @@ func (srv *EssService) EssRemoveInstances(id string, instanceIds []string) error
 	if group.LifecycleState == string(Inactive) {
 		return WrapError(Error("Scaling group current status is %s, please active it before attaching or removing ECS instances.", group.LifecycleState))
 	} else {
-		if err := srv.WaitForEssScalingGroup(group.ScalingGroupId, Active, DefaultTimeout); err != nil {
+		if err := srv.WaitForEssScalingGroup(group.ScalingGroupId, Active, timeout); err != nil {
 			if NotFoundError(err) {
 				return nil
 			}
@@ -419,7 +421,7 @@ func (srv *EssService) EssRemoveInstances(id string, instanceIds []string) error
 			return essClient.RemoveInstances(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, InvalidScalingGroupIdNotFound) {
+			if IsProductError(err, connectivity.ESSCode, ErrorNotFound) {
 				return nil
 			}
 			if IsExceptedError(err, IncorrectCapacityMinSize) {
@@ -432,13 +434,17 @@ func (srv *EssService) EssRemoveInstances(id string, instanceIds []string) error
 				}
 			}
 			if IsExceptedError(err, ScalingActivityInProgress) || IsExceptedError(err, IncorrectScalingGroupStatus) {
-				time.Sleep(5)
+				if err := sleepContext(srv.client, 5*time.Second); err != nil {
+					return resource.NonRetryableError(WrapError(err))
+				}
 				return resource.RetryableError(WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR))
 			}
 			return resource.NonRetryableError(WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR))
 		}
 		addDebug(request.GetActionName(), raw)
-		time.Sleep(3 * time.Second)
+		if err := sleepContext(srv.client, 3*time.Second); err != nil {
+			return resource.NonRetryableError(WrapError(err))
+		}
 		instances, err := srv.DescribeEssAttachment(id, instanceIds)
 		if err != nil {
 			if NotFoundError(err) {
@@ -479,7 +485,9 @@ func (s *EssService) WaitForEssScalingGroup(id string, status Status, timeout in
 		if object.LifecycleState == string(status) {
 			return nil
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.LifecycleState, string(status), ProviderERROR)
 		}
@@ -515,7 +523,9 @@ func (s *EssService) WaitForEssAttachment(id string, status Status, timeout int)
 		if len(object) > 0 && status != Deleted {
 			return nil
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, Null, string(status), ProviderERROR)
 		}
@@ -538,7 +548,9 @@ func (s *EssService) WaitForEssAlarm(id string, status Status, timeout int) erro
 		if object.AlarmTaskId == id && status != Deleted {
 			return nil
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.AlarmTaskId, id, ProviderERROR)
 		}
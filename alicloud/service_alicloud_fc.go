@@ -17,7 +17,7 @@ func (s *FcService) DescribeFcService(id string) (response *fc.GetServiceOutput,
 		return fcClient.GetService(&fc.GetServiceInput{ServiceName: &id})
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{ServiceNotFound}) {
+		if IsProductError(err, connectivity.FCCode, ErrorNotFound) {
 			err = WrapErrorf(err, NotFoundMsg, FcGoSdk)
 		} else {
 			err = WrapErrorf(err, DefaultErrorMsg, id, "GetService", FcGoSdk)
@@ -48,7 +48,9 @@ func (s *FcService) WaitForFcService(id string, status Status, timeout int) erro
 		if *object.ServiceName == id && status != Deleted {
 			return nil
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, *object.ServiceName, id, ProviderERROR)
 		}
@@ -70,7 +72,7 @@ func (s *FcService) DescribeFcFunction(id string) (response *fc.GetFunctionOutpu
 		})
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{ServiceNotFound, FunctionNotFound}) {
+		if IsProductError(err, connectivity.FCCode, ErrorNotFound) {
 			err = WrapErrorf(err, NotFoundMsg, FcGoSdk)
 		} else {
 			err = WrapErrorf(err, DefaultErrorMsg, id, "GetFunction", FcGoSdk)
@@ -105,7 +107,9 @@ func (s *FcService) WaitForFcFunction(id string, status Status, timeout int) err
 		if *object.FunctionName == parts[1] && status != Deleted {
 			break
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, *object.FunctionName, parts[1], ProviderERROR)
 		}
@@ -124,7 +128,7 @@ func (s *FcService) DescribeFcTrigger(id string) (response *fc.GetTriggerOutput,
 		return fcClient.GetTrigger(fc.NewGetTriggerInput(service, function, name))
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{ServiceNotFound, FunctionNotFound, TriggerNotFound}) {
+		if IsProductError(err, connectivity.FCCode, ErrorNotFound) {
 			err = WrapErrorf(err, NotFoundMsg, FcGoSdk)
 		} else {
 			err = WrapErrorf(err, DefaultErrorMsg, id, "FcTrigger", FcGoSdk)
@@ -166,7 +170,9 @@ func (s *FcService) WaitForFcTrigger(id string, status Status, timeout int) erro
 		if *object.TriggerName == parts[2] {
 			break
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, *object.TriggerName, parts[2], ProviderERROR)
 		}
@@ -25,7 +25,7 @@ func (s *HaVipService) DescribeHaVip(haVipId string) (v vpc.HaVip, err error) {
 	}
 	request.Filter = &filter
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeHaVips(request)
@@ -61,13 +61,15 @@ func (s *HaVipService) WaitForHaVip(haVipId string, status Status, timeout int)
 		if timeout <= 0 {
 			return GetTimeErrorFromString(GetTimeoutMessage("HaVip", string(status)))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
 
 func (s *HaVipService) DescribeHaVipAttachment(haVipId string, instanceId string) (err error) {
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	return invoker.Run(func() error {
 		haVip, err := s.DescribeHaVip(haVipId)
 		if err != nil {
@@ -100,7 +102,9 @@ func (s *HaVipService) WaitForHaVipAttachment(haVipId string, instanceId string,
 		if timeout <= 0 {
 			return GetTimeErrorFromString(GetTimeoutMessage("HaVip Attachment", string("Unavailable")))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
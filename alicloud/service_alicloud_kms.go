@@ -1,10 +1,13 @@
 package alicloud
 
 import (
+	"encoding/json"
 	"log"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
@@ -55,3 +58,87 @@ func (k *KmsService) WaitForKmsKey(id string, status Status, timeout int) error
 		}
 	}
 }
+
+func (k *KmsService) DescribeKmsKeyTags(id string) (map[string]string, error) {
+	request := kms.CreateListResourceTagsRequest()
+	request.KeyId = id
+
+	raw, err := k.client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
+		return kmsClient.ListResourceTags(request)
+	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw)
+	response, _ := raw.(*kms.ListResourceTagsResponse)
+	var tags []ecs.Tag
+	for _, t := range response.Tags.Tag {
+		tags = append(tags, ecs.Tag{TagKey: t.TagKey, TagValue: t.TagValue})
+	}
+	return tagsToMap(tags), nil
+}
+
+func (k *KmsService) SetKmsKeyTags(d *schema.ResourceData) error {
+	o, n, changed := tagsChange(k.client, d)
+	if !changed {
+		return nil
+	}
+	create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+
+	var removedKeys []string
+	for _, t := range remove {
+		if _, ok := n[t.Key]; !ok {
+			removedKeys = append(removedKeys, t.Key)
+		}
+	}
+	for start := 0; start < len(removedKeys); start += TagResourcesMaxNumPerTime {
+		end := start + TagResourcesMaxNumPerTime
+		if end > len(removedKeys) {
+			end = len(removedKeys)
+		}
+		request := kms.CreateUntagResourceRequest()
+		request.KeyId = d.Id()
+		keys, err := json.Marshal(removedKeys[start:end])
+		if err != nil {
+			return WrapError(err)
+		}
+		request.TagKeys = string(keys)
+		raw, err := k.client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
+			return kmsClient.UntagResource(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw)
+	}
+
+	for start := 0; start < len(create); start += TagResourcesMaxNumPerTime {
+		end := start + TagResourcesMaxNumPerTime
+		if end > len(create) {
+			end = len(create)
+		}
+		var tags []map[string]string
+		for _, t := range create[start:end] {
+			tags = append(tags, map[string]string{"TagKey": t.Key, "TagValue": t.Value})
+		}
+		request := kms.CreateTagResourceRequest()
+		request.KeyId = d.Id()
+		value, err := json.Marshal(tags)
+		if err != nil {
+			return WrapError(err)
+		}
+		request.Tags = string(value)
+		raw, err := k.client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
+			return kmsClient.TagResource(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw)
+	}
+
+	d.Set("tags_all", n)
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+	return nil
+}
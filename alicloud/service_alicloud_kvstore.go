@@ -18,7 +18,7 @@ func (s *KvstoreService) DescribeKVstoreInstance(id string) (instance *r_kvstore
 		return rkvClient.DescribeInstanceAttribute(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, InvalidKVStoreInstanceIdNotFound) {
+		if IsProductError(err, connectivity.KVSTORECode, ErrorNotFound) {
 			return nil, WrapErrorf(Error(GetNotFoundMessage("KVstoreInstance", id)), NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -39,7 +39,7 @@ func (s *KvstoreService) DescribeKVstoreBackupPolicy(id string) (response *r_kvs
 		return rkvClient.DescribeBackupPolicy(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, InvalidKVStoreInstanceIdNotFound) {
+		if IsProductError(err, connectivity.KVSTORECode, ErrorNotFound) {
 			return nil, WrapErrorf(Error(GetNotFoundMessage("KVstoreBackupPolicy", id)), NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -97,7 +97,7 @@ func (s *KvstoreService) DescribeParameters(id string) (ds *r_kvstore.DescribePa
 		return rkvClient.DescribeParameters(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidDBInstanceIdNotFound, InvalidDBInstanceNameNotFound}) {
+		if IsProductError(err, connectivity.KVSTORECode, ErrorNotFound) {
 			return nil, WrapErrorf(Error(GetNotFoundMessage("Parameters", id)), NotFoundMsg, ProviderERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -107,12 +107,12 @@ func (s *KvstoreService) DescribeParameters(id string) (ds *r_kvstore.DescribePa
 	return response, err
 }
 
-func (s *KvstoreService) ModifyInstanceConfig(id string, config string) error {
+func (s *KvstoreService) ModifyInstanceConfig(id string, config string, timeout int) error {
 	request := r_kvstore.CreateModifyInstanceConfigRequest()
 	request.InstanceId = id
 	request.Config = config
 
-	if err := s.WaitForKVstoreInstance(id, Normal, DefaultLongTimeout); err != nil {
+	if err := s.WaitForKVstoreInstance(id, Normal, timeout); err != nil {
 		return WrapError(err)
 	}
 	raw, err := s.client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
@@ -15,13 +15,13 @@ type LogService struct {
 }
 
 func (s *LogService) DescribeLogProject(id string) (project *sls.LogProject, err error) {
+	wait := incrementalWait(s.client, 5*time.Second, 5*time.Second)
 	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			return slsClient.GetProject(id)
 		})
 		if err != nil {
-			if IsExceptedError(err, LogClientTimeout) {
-				time.Sleep(5 * time.Second)
+			if IsExceptedError(err, LogClientTimeout) && wait() {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -31,7 +31,7 @@ func (s *LogService) DescribeLogProject(id string) (project *sls.LogProject, err
 		return nil
 	})
 	if err != nil {
-		if IsExceptedError(err, ProjectNotExist) {
+		if IsProductError(err, connectivity.LOGCode, ErrorNotFound) {
 			return project, WrapErrorf(err, NotFoundMsg, AliyunLogGoSdkERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, "GetProject", AliyunLogGoSdkERROR)
@@ -85,7 +85,7 @@ func (s *LogService) DescribeLogStore(id string) (store *sls.LogStore, err error
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{ProjectNotExist, LogStoreNotExist}) {
+		if IsProductError(err, connectivity.LOGCode, ErrorNotFound) {
 			return store, WrapErrorf(err, NotFoundMsg, AliyunLogGoSdkERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, "GetLogStore", AliyunLogGoSdkERROR)
@@ -145,7 +145,7 @@ func (s *LogService) DescribeLogStoreIndex(id string) (index *sls.Index, err err
 	})
 
 	if err != nil {
-		if IsExceptedErrors(err, []string{ProjectNotExist, LogStoreNotExist, IndexConfigNotExist}) {
+		if IsProductError(err, connectivity.LOGCode, ErrorNotFound) {
 			return index, WrapErrorf(err, NotFoundMsg, AliyunLogGoSdkERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, "GetIndex", AliyunLogGoSdkERROR)
@@ -179,7 +179,7 @@ func (s *LogService) DescribeLogMachineGroup(id string) (group *sls.MachineGroup
 	})
 
 	if err != nil {
-		if IsExceptedErrors(err, []string{ProjectNotExist, GroupNotExist, MachineGroupNotExist}) {
+		if IsProductError(err, connectivity.LOGCode, ErrorNotFound) {
 			return group, WrapErrorf(err, NotFoundMsg, AliyunLogGoSdkERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, "GetMachineGroup", AliyunLogGoSdkERROR)
@@ -224,7 +224,7 @@ func (s *LogService) DescribeLogLogtailConfig(projectName, configName string) (l
 			return slsClient.GetConfig(projectName, configName)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ProjectNotExist, LogStoreNotExist, LogConfigNotExist}) {
+			if IsProductError(err, connectivity.LOGCode, ErrorNotFound) {
 				return resource.NonRetryableError(WrapErrorf(err, NotFoundMsg, AliyunLogGoSdkERROR))
 			}
 			if IsExceptedErrors(err, []string{InternalServerError}) {
@@ -267,7 +267,7 @@ func (s *LogService) DescribeLogtailAttachment(id string) (groupName string, err
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{ProjectNotExist, LogConfigNotExist, MachineGroupNotExist}) {
+		if IsProductError(err, connectivity.LOGCode, ErrorNotFound) {
 			return groupName, WrapErrorf(err, NotFoundMsg, AliyunLogGoSdkERROR)
 		}
 		return groupName, WrapErrorf(err, DefaultErrorMsg, id, "GetAppliedMachineGroups", AliyunLogGoSdkERROR)
@@ -33,7 +33,7 @@ func (s *MongoDBService) DescribeMongoDBInstance(id string) (instance dds.DBInst
 	})
 	response, _ := raw.(*dds.DescribeDBInstanceAttributeResponse)
 	if err != nil {
-		if IsExceptedErrors(err, []string{"InvalidDBInstanceId.NotFound"}) {
+		if IsProductError(err, connectivity.DDSCode, ErrorNotFound) {
 			return instance, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return instance, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -76,7 +76,9 @@ func (s *MongoDBService) WaitForMongoDBInstance(instanceId string, status Status
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, instanceId, GetFunc(1), timeout, instance.DBInstanceStatus, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -143,7 +145,7 @@ func (s *MongoDBService) DescribeMongoDBSecurityIps(instanceId string) (ips []dd
 	return respone.SecurityIpGroups.SecurityIpGroup, nil
 }
 
-func (s *MongoDBService) ModifyMongoDBSecurityIps(instanceId, ips string) error {
+func (s *MongoDBService) ModifyMongoDBSecurityIps(instanceId, ips string, timeout int) error {
 	request := dds.CreateModifySecurityIpsRequest()
 	request.DBInstanceId = instanceId
 	request.SecurityIps = ips
@@ -158,17 +160,17 @@ func (s *MongoDBService) ModifyMongoDBSecurityIps(instanceId, ips string) error
 	respone := raw.(*dds.ModifySecurityIpsResponse)
 	addDebug(request.GetActionName(), respone)
 
-	if err := s.WaitForMongoDBInstance(instanceId, Running, DefaultTimeoutMedium); err != nil {
+	if err := s.WaitForMongoDBInstance(instanceId, Running, timeout); err != nil {
 		return WrapError(err)
 	}
 	return nil
 }
 
 func (server *MongoDBService) ModifyMongodbShardingInstanceNode(
-	instanceID string, nodeType MongoDBShardingNodeType, stateList, diffList []interface{}) error {
+	instanceID string, nodeType MongoDBShardingNodeType, stateList, diffList []interface{}, timeout int) error {
 	client := server.client
 
-	err := server.WaitForMongoDBInstance(instanceID, Running, DefaultLongTimeout)
+	err := server.WaitForMongoDBInstance(instanceID, Running, timeout)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -199,12 +201,12 @@ func (server *MongoDBService) ModifyMongodbShardingInstanceNode(
 			}
 			addDebug(request.GetActionName(), raw)
 
-			err = server.WaitForMongoDBInstance(instanceID, Updating, DefaultLongTimeout)
+			err = server.WaitForMongoDBInstance(instanceID, Updating, timeout)
 			if err != nil {
 				return WrapError(err)
 			}
 
-			err = server.WaitForMongoDBInstance(instanceID, Running, DefaultLongTimeout)
+			err = server.WaitForMongoDBInstance(instanceID, Running, timeout)
 			if err != nil {
 				return WrapError(err)
 			}
@@ -230,7 +232,7 @@ func (server *MongoDBService) ModifyMongodbShardingInstanceNode(
 
 			addDebug(request.GetActionName(), raw)
 
-			err = server.WaitForMongoDBInstance(instanceID, Running, DefaultLongTimeout)
+			err = server.WaitForMongoDBInstance(instanceID, Running, timeout)
 			if err != nil {
 				return WrapError(err)
 			}
@@ -261,11 +263,11 @@ func (server *MongoDBService) ModifyMongodbShardingInstanceNode(
 				return WrapErrorf(err, DefaultErrorMsg, instanceID, request.GetActionName(), AlibabaCloudSdkGoERROR)
 			}
 			addDebug(request.GetActionName(), raw)
-			err = server.WaitForMongoDBInstance(instanceID, Updating, DefaultLongTimeout)
+			err = server.WaitForMongoDBInstance(instanceID, Updating, timeout)
 			if err != nil {
 				return WrapError(err)
 			}
-			err = server.WaitForMongoDBInstance(instanceID, Running, DefaultLongTimeout)
+			err = server.WaitForMongoDBInstance(instanceID, Running, timeout)
 			if err != nil {
 				return WrapError(err)
 			}
@@ -289,7 +291,7 @@ func (s *MongoDBService) DescribeMongoDBBackupPolicy(id string) (response *dds.D
 }
 
 func (s *MongoDBService) MotifyMongoDBBackupPolicy(d *schema.ResourceData) error {
-	if err := s.WaitForMongoDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
+	if err := s.WaitForMongoDBInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 		return WrapError(err)
 	}
 	periodList := expandStringList(d.Get("backup_period").(*schema.Set).List())
@@ -307,7 +309,7 @@ func (s *MongoDBService) MotifyMongoDBBackupPolicy(d *schema.ResourceData) error
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw)
-	if err := s.WaitForMongoDBInstance(d.Id(), Running, DefaultTimeoutMedium); err != nil {
+	if err := s.WaitForMongoDBInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 		return WrapError(err)
 	}
 	return nil
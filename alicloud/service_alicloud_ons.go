@@ -31,7 +31,7 @@ func (s *OnsService) DescribeOnsInstance(id string) (*ons.OnsInstanceBaseInfoRes
 	})
 
 	if err != nil {
-		if IsExceptedError(err, InvalidDomainNameNoExist) {
+		if IsProductError(err, connectivity.ONSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -60,7 +60,7 @@ func (s *OnsService) DescribeOnsTopic(id string) (onsTopic *ons.PublishInfoDo, e
 	})
 
 	if err != nil {
-		if IsExceptedErrors(err, []string{AuthResourceOwnerError, OnsInstanceNotExist}) {
+		if IsProductError(err, connectivity.ONSCode, ErrorNotFound) {
 			return onsTopic, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return onsTopic, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -94,7 +94,7 @@ func (s *OnsService) DescribeOnsGroup(id string) (onsGroup *ons.SubscribeInfoDo,
 		return onsClient.OnsGroupList(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{AuthResourceOwnerError, OnsInstanceNotExist}) {
+		if IsProductError(err, connectivity.ONSCode, ErrorNotFound) {
 			return onsGroup, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return onsGroup, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -135,7 +135,9 @@ func (s *OnsService) WaitForOnsInstance(id string, status Status, timeout int) e
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, response.InstanceBaseInfo.InstanceId, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 
 }
@@ -167,7 +169,9 @@ func (s *OnsService) WaitForOnsTopic(id string, status Status, timeout int) erro
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, instanceId+":"+topic, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -193,6 +197,8 @@ func (s *OnsService) WaitForOnsGroup(id string, status Status, timeout int) erro
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, response.InstanceId+":"+response.GroupId, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
@@ -248,7 +248,9 @@ func (s *OtsService) WaitForOtsInstance(name string, status Status, timeout int)
 		if timeout <= 0 {
 			return GetTimeErrorFromString(GetTimeoutMessage("OTS Instance", string(status)))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -18,13 +18,13 @@ func (s *PvtzService) DescribePvtzZone(id string) (zone pvtz.DescribeZoneInfoRes
 	request := pvtz.CreateDescribeZoneInfoRequest()
 	request.ZoneId = id
 
-	invoker := PvtzInvoker()
+	invoker := PvtzInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithPvtzClient(func(pvtzClient *pvtz.Client) (interface{}, error) {
 			return pvtzClient.DescribeZoneInfo(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{ZoneNotExists, ZoneVpcNotExists}) {
+			if IsProductError(err, connectivity.PVTZCode, ErrorNotFound) {
 				return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
 			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -98,7 +98,7 @@ func (s *PvtzService) DescribeZoneRecord(id string) (record pvtz.Record, err err
 
 	recordIdStr := parts[0]
 
-	invoker := PvtzInvoker()
+	invoker := PvtzInvoker(s.client)
 	err = invoker.Run(func() error {
 		for {
 			raw, err := s.client.WithPvtzClient(func(pvtzClient *pvtz.Client) (interface{}, error) {
@@ -106,7 +106,7 @@ func (s *PvtzService) DescribeZoneRecord(id string) (record pvtz.Record, err err
 			})
 
 			if err != nil {
-				if IsExceptedErrors(err, []string{ZoneNotExists, ZoneVpcNotExists}) {
+				if IsProductError(err, connectivity.PVTZCode, ErrorNotFound) {
 					return WrapErrorf(Error(GetNotFoundMessage("ZoneRecord", id)), NotFoundMsg, AlibabaCloudSdkGoERROR)
 				}
 				return WrapErrorf(err, DefaultErrorMsg, recordIdStr, request.GetActionName(), AlibabaCloudSdkGoERROR)
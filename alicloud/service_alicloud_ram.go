@@ -517,7 +517,7 @@ func (s *RamService) DescribeRamRoleAttachment(id string) (response *ecs.Describ
 		return nil
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidRamRoleNotFound}) {
+		if IsProductError(err, connectivity.RAMCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
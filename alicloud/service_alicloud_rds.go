@@ -33,7 +33,7 @@ type RdsService struct {
 // The API return 200 for resource not found.
 // When getInstance is empty, then throw InstanceNotfound error.
 // That the business layer only need to check error.
-var DBInstanceStatusCatcher = Catcher{"OperationDenied.DBInstanceStatus", 60, 5}
+var DBInstanceStatusCatcher = Catcher{string(ErrorConflict), 60, 5}
 
 func (s *RdsService) DescribeDBInstance(id string) (instance *rds.DBInstanceAttribute, err error) {
 
@@ -43,7 +43,7 @@ func (s *RdsService) DescribeDBInstance(id string) (instance *rds.DBInstanceAttr
 		return rdsClient.DescribeDBInstanceAttribute(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidDBInstanceIdNotFound, InvalidDBInstanceNameNotFound}) {
+		if IsProductError(err, connectivity.RDSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -65,7 +65,7 @@ func (s *RdsService) DescribeDBReadonlyInstance(id string) (instance *rds.DBInst
 		return rdsClient.DescribeDBInstanceAttribute(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidDBInstanceIdNotFound, InvalidDBInstanceNameNotFound}) {
+		if IsProductError(err, connectivity.RDSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -88,7 +88,7 @@ func (s *RdsService) DescribeDBAccount(id string) (ds *rds.DBInstanceAccount, er
 	request := rds.CreateDescribeAccountsRequest()
 	request.DBInstanceId = parts[0]
 	request.AccountName = parts[1]
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	invoker.AddCatcher(DBInstanceStatusCatcher)
 	var response *rds.DescribeAccountsResponse
 	if err := invoker.Run(func() error {
@@ -104,7 +104,7 @@ func (s *RdsService) DescribeDBAccount(id string) (ds *rds.DBInstanceAccount, er
 		response, _ = raw.(*rds.DescribeAccountsResponse)
 		return nil
 	}); err != nil {
-		if IsExceptedError(err, InvalidDBInstanceIdNotFound) {
+		if IsProductError(err, connectivity.RDSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -125,7 +125,7 @@ func (s *RdsService) DescribeDBAccountPrivilege(id string) (ds *rds.DBInstanceAc
 	request := rds.CreateDescribeAccountsRequest()
 	request.DBInstanceId = parts[0]
 	request.AccountName = parts[1]
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	invoker.AddCatcher(DBInstanceStatusCatcher)
 	var response *rds.DescribeAccountsResponse
 	if err := invoker.Run(func() error {
@@ -139,7 +139,7 @@ func (s *RdsService) DescribeDBAccountPrivilege(id string) (ds *rds.DBInstanceAc
 		response, _ = raw.(*rds.DescribeAccountsResponse)
 		return nil
 	}); err != nil {
-		if IsExceptedError(err, InvalidDBInstanceIdNotFound) {
+		if IsProductError(err, connectivity.RDSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -195,7 +195,7 @@ func (s *RdsService) DescribeParameters(id string) (ds *rds.DescribeParametersRe
 		return rdsClient.DescribeParameters(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidDBInstanceIdNotFound, InvalidDBInstanceNameNotFound}) {
+		if IsProductError(err, connectivity.RDSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -269,7 +269,7 @@ func (s *RdsService) ModifyParameters(d *schema.ResourceData, attribute string)
 		cfg, _ := json.Marshal(config)
 		request.Parameters = string(cfg)
 		// wait instance status is Normal before modifying
-		if err := s.WaitForDBInstance(d.Id(), Running, DefaultLongTimeout); err != nil {
+		if err := s.WaitForDBInstance(d.Id(), Running, timeoutSeconds(d, schema.TimeoutUpdate)); err != nil {
 			return WrapError(err)
 		}
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
@@ -281,7 +281,7 @@ func (s *RdsService) ModifyParameters(d *schema.ResourceData, attribute string)
 
 		addDebug(request.GetActionName(), raw)
 		// wait instance parameter expect after modifying
-		if err := s.WaitForDBParameter(d.Id(), DefaultTimeoutMedium, config); err != nil {
+		if err := s.WaitForDBParameter(d.Id(), timeoutSeconds(d, schema.TimeoutUpdate), config); err != nil {
 			return WrapError(err)
 		}
 	}
@@ -298,7 +298,7 @@ func (s *RdsService) DescribeDBInstanceNetInfo(id string) ([]rds.DBInstanceNetIn
 	})
 
 	if err != nil {
-		if IsExceptedError(err, InvalidDBInstanceIdNotFound) {
+		if IsProductError(err, connectivity.RDSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -322,7 +322,7 @@ func (s *RdsService) DescribeDBConnection(id string) (*rds.DBInstanceNetInfo, er
 	object, err := s.DescribeDBInstanceNetInfo(parts[0])
 
 	if err != nil {
-		if IsExceptedError(err, InvalidCurrentConnectionStringNotFound) {
+		if IsProductError(err, connectivity.RDSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapError(err)
@@ -362,7 +362,7 @@ func (s *RdsService) DescribeDBReadWriteSplittingConnection(id string) (*rds.DBI
 	return nil, WrapErrorf(Error(GetNotFoundMessage("ReadWriteSplittingConnection", id)), NotFoundMsg, ProviderERROR)
 }
 
-func (s *RdsService) GrantAccountPrivilege(id, dbName string) error {
+func (s *RdsService) GrantAccountPrivilege(id, dbName string, timeout int) error {
 	parts, err := ParseResourceId(id, 3)
 	if err != nil {
 		return WrapError(err)
@@ -393,14 +393,14 @@ func (s *RdsService) GrantAccountPrivilege(id, dbName string) error {
 		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	if err := s.WaitForAccountPrivilege(id, dbName, Available, DefaultTimeoutMedium); err != nil {
+	if err := s.WaitForAccountPrivilege(id, dbName, Available, timeout); err != nil {
 		return WrapError(err)
 	}
 
 	return nil
 }
 
-func (s *RdsService) RevokeAccountPrivilege(id, dbName string) error {
+func (s *RdsService) RevokeAccountPrivilege(id, dbName string, timeout int) error {
 	parts, err := ParseResourceId(id, 3)
 	if err != nil {
 		return WrapError(err)
@@ -430,7 +430,7 @@ func (s *RdsService) RevokeAccountPrivilege(id, dbName string) error {
 		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
 	}
 
-	if err := s.WaitForAccountPrivilegeRevoked(id, dbName, DefaultTimeoutMedium); err != nil {
+	if err := s.WaitForAccountPrivilegeRevoked(id, dbName, timeout); err != nil {
 		return WrapError(err)
 	}
 
@@ -455,7 +455,7 @@ func (s *RdsService) ReleaseDBPublicConnection(instanceId, connection string) er
 	return nil
 }
 
-func (s *RdsService) ModifyDBBackupPolicy(instanceId, backupTime, backupPeriod, retentionPeriod, backupLog, LogBackupRetentionPeriod string) error {
+func (s *RdsService) ModifyDBBackupPolicy(instanceId, backupTime, backupPeriod, retentionPeriod, backupLog, LogBackupRetentionPeriod string, timeout int) error {
 
 	request := rds.CreateModifyBackupPolicyRequest()
 	request.DBInstanceId = instanceId
@@ -474,13 +474,13 @@ func (s *RdsService) ModifyDBBackupPolicy(instanceId, backupTime, backupPeriod,
 
 	addDebug(request.GetActionName(), raw)
 
-	if err := s.WaitForDBInstance(instanceId, Running, DefaultTimeoutMedium); err != nil {
+	if err := s.WaitForDBInstance(instanceId, Running, timeout); err != nil {
 		return WrapError(err)
 	}
 	return nil
 }
 
-func (s *RdsService) ModifyDBSecurityIps(instanceId, ips string) error {
+func (s *RdsService) ModifyDBSecurityIps(instanceId, ips string, timeout int) error {
 
 	request := rds.CreateModifySecurityIpsRequest()
 	request.DBInstanceId = instanceId
@@ -495,7 +495,7 @@ func (s *RdsService) ModifyDBSecurityIps(instanceId, ips string) error {
 
 	addDebug(request.GetActionName(), raw)
 
-	if err := s.WaitForDBInstance(instanceId, Running, DefaultTimeoutMedium); err != nil {
+	if err := s.WaitForDBInstance(instanceId, Running, timeout); err != nil {
 		return WrapError(err)
 	}
 	return nil
@@ -620,7 +620,7 @@ func (s *RdsService) DescribeBackupPolicy(id string) (policy *rds.DescribeBackup
 	})
 
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidDBInstanceIdNotFound, InvalidDBInstanceNameNotFound}) {
+		if IsProductError(err, connectivity.RDSCode, ErrorNotFound) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return policy, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -669,7 +669,9 @@ func (s *RdsService) WaitForDBInstance(id string, status Status, timeout int) er
 		if strings.ToLower(object.DBInstanceStatus) == strings.ToLower(string(status)) {
 			break
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.DBInstanceStatus, status, ProviderERROR)
 		}
@@ -739,7 +741,9 @@ func (s *RdsService) WaitForDBParameter(instanceId string, timeout int, expects
 			break
 		}
 
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, instanceId, GetFunc(1), timeout, got_value, expected_value, ProviderERROR)
@@ -786,7 +790,9 @@ func (s *RdsService) WaitForDBReadWriteSplitting(id string, status Status, timeo
 		if err == nil {
 			break
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.ConnectionString, id, ProviderERROR)
 		}
@@ -906,7 +912,9 @@ func (s *RdsService) WaitForDBDatabase(id string, status Status, timeout int) er
 		if object.DBName == parts[1] {
 			break
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.DBName, parts[1], ProviderERROR)
 		}
@@ -967,10 +975,7 @@ func (s *RdsService) NotFoundDBInstance(err error) bool {
 const tagsMaxNumPerTime = 5
 
 func (s *RdsService) setInstanceTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	if o, n, changed := tagsChange(s.client, d); changed {
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
 
 		if len(remove) > 0 {
@@ -985,7 +990,9 @@ func (s *RdsService) setInstanceTags(d *schema.ResourceData) error {
 			}
 		}
 
+		d.Set("tags_all", n)
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	return nil
@@ -48,7 +48,7 @@ func (s *SlbService) DescribeSlb(id string) (response *slb.DescribeLoadBalancerA
 		return slbClient.DescribeLoadBalancerAttribute(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{LoadBalancerNotFound}) {
+		if IsProductError(err, connectivity.SLBCode, ErrorNotFound) {
 			err = WrapErrorf(Error(GetNotFoundMessage("Slb", id)), NotFoundMsg, AlibabaCloudSdkGoERROR)
 		} else {
 			err = WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -70,7 +70,7 @@ func (s *SlbService) DescribeSlbRule(id string) (*slb.DescribeRuleAttributeRespo
 		return slbClient.DescribeRuleAttribute(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{InvalidRuleIdNotFound}) {
+		if IsProductError(err, connectivity.SLBCode, ErrorNotFound) {
 			return nil, WrapErrorf(Error(GetNotFoundMessage("SlbRule", id)), NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -90,7 +90,7 @@ func (s *SlbService) DescribeSlbServerGroup(id string) (*slb.DescribeVServerGrou
 		return slbClient.DescribeVServerGroupAttribute(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{VServerGroupNotFoundMessage, InvalidParameter}) {
+		if IsProductError(err, connectivity.SLBCode, ErrorNotFound) || IsExceptedError(err, InvalidParameter) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -110,7 +110,7 @@ func (s *SlbService) DescribeSlbBackendServer(id string) (*slb.DescribeLoadBalan
 		return slbClient.DescribeLoadBalancerAttribute(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{LoadBalancerNotFound}) {
+		if IsProductError(err, connectivity.SLBCode, ErrorNotFound) {
 			err = WrapErrorf(Error(GetNotFoundMessage("SlbBackendServers", id)), NotFoundMsg, AlibabaCloudSdkGoERROR)
 		} else {
 			err = WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -147,7 +147,7 @@ func (s *SlbService) DescribeSlbListener(id string, protocol Protocol) (listener
 		})
 
 		if err != nil {
-			if IsExceptedError(err, ListenerNotFound) {
+			if IsProductError(err, connectivity.SLBCode, ErrorNotFound) || IsExceptedError(err, ListenerNotFound) {
 				return resource.NonRetryableError(WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR))
 			} else if IsExceptedErrors(err, SlbIsBusy) {
 				return resource.RetryableError(WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR))
@@ -194,7 +194,7 @@ func (s *SlbService) DescribeSlbAcl(id string) (response *slb.DescribeAccessCont
 	})
 	if err != nil {
 		if err != nil {
-			if IsExceptedError(err, SlbAclNotExists) {
+			if IsProductError(err, connectivity.SLBCode, ErrorNotFound) {
 				return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
 			return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -221,7 +221,9 @@ func (s *SlbService) WaitForSlbAcl(id string, status Status, timeout int) error
 			return nil
 		}
 
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.AclId, id, ProviderERROR)
 		}
@@ -247,7 +249,9 @@ func (s *SlbService) WaitForSlb(id string, status Status, timeout int) error {
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.LoadBalancerStatus, status, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -256,7 +260,7 @@ func (s *SlbService) WaitForSlbListener(id string, protocol Protocol, status Sta
 	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
 	for {
 		object, err := s.DescribeSlbListener(id, protocol)
-		if err != nil && !IsExceptedErrors(err, []string{LoadBalancerNotFound}) {
+		if err != nil && !IsProductError(err, connectivity.SLBCode, ErrorNotFound) {
 			if NotFoundError(err) {
 				if status == Deleted {
 					return nil
@@ -275,7 +279,9 @@ func (s *SlbService) WaitForSlbListener(id string, protocol Protocol, status Sta
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, gotStatus, status, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -300,7 +306,9 @@ func (s *SlbService) WaitForSlbRule(id string, status Status, timeout int) error
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, "", id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -323,7 +331,9 @@ func (s *SlbService) WaitForSlbServerGroup(id string, status Status, timeout int
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.VServerGroupId, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -568,10 +578,7 @@ func (s *SlbService) readFileContent(file_name string) (string, error) {
 // tags field to be named "tags"
 func (s *SlbService) setSlbInstanceTags(d *schema.ResourceData) error {
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		o := oraw.(map[string]interface{})
-		n := nraw.(map[string]interface{})
+	if o, n, changed := tagsChange(s.client, d); changed {
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
 
 		// Set tags
@@ -587,7 +594,9 @@ func (s *SlbService) setSlbInstanceTags(d *schema.ResourceData) error {
 			}
 		}
 
+		d.Set("tags_all", n)
 		d.SetPartial("tags")
+		d.SetPartial("tags_all")
 	}
 
 	return nil
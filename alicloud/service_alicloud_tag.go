@@ -0,0 +1,250 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/dds"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	r_kvstore "github.com/aliyun/alibaba-cloud-sdk-go/services/r-kvstore"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// The max number of tags, tag keys or resource IDs in one TagResources, UntagResources or ListTagResources request.
+const TagResourcesMaxNumPerTime = 20
+
+// TagProduct describes a product which supports the TagResources, UntagResources and ListTagResources APIs.
+type TagProduct struct {
+	Code        connectivity.ServiceCode
+	Product     string
+	ServiceCode string
+	Version     connectivity.ApiVersion
+}
+
+var (
+	VpcTagProduct     = TagProduct{connectivity.VPCCode, "Vpc", "vpc", connectivity.ApiVersion20160428}
+	KVStoreTagProduct = TagProduct{connectivity.KVSTORECode, "R-kvstore", "redisa", connectivity.ApiVersion20150101}
+	DdsTagProduct     = TagProduct{connectivity.DDSCode, "Dds", "dds", connectivity.ApiVersion20151201}
+	EcsTagProduct     = TagProduct{connectivity.ECSCode, "Ecs", "ecs", connectivity.ApiVersion20140526}
+)
+
+type TagResource struct {
+	ResourceId   string `json:"ResourceId"`
+	ResourceType string `json:"ResourceType"`
+	TagKey       string `json:"TagKey"`
+	TagValue     string `json:"TagValue"`
+}
+
+type listTagResourcesResponse struct {
+	NextToken    string `json:"NextToken"`
+	TagResources struct {
+		TagResource []TagResource `json:"TagResource"`
+	} `json:"TagResources"`
+}
+
+type TagService struct {
+	client *connectivity.AliyunClient
+}
+
+func (s *TagService) newCommonRequest(product TagProduct, action string, resourceType TagResourceType) (*requests.CommonRequest, error) {
+	request, err := s.client.NewCommonRequest(product.Product, product.ServiceCode, strings.ToUpper(string(Https)), product.Version)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	request.ApiName = action
+	request.QueryParams["RegionId"] = s.client.RegionId
+	request.QueryParams["ResourceType"] = string(resourceType)
+	return request, nil
+}
+
+func (s *TagService) processCommonRequest(product TagProduct, request *requests.CommonRequest) (*responses.CommonResponse, error) {
+	var raw interface{}
+	var err error
+	switch product.Code {
+	case connectivity.VPCCode:
+		raw, err = s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.ProcessCommonRequest(request)
+		})
+	case connectivity.KVSTORECode:
+		raw, err = s.client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
+			return rkvClient.ProcessCommonRequest(request)
+		})
+	case connectivity.DDSCode:
+		raw, err = s.client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
+			return ddsClient.ProcessCommonRequest(request)
+		})
+	case connectivity.ECSCode:
+		raw, err = s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ProcessCommonRequest(request)
+		})
+	default:
+		return nil, WrapError(fmt.Errorf("The product %s does not support tagging resources.", product.Product))
+	}
+	if err != nil {
+		return nil, err
+	}
+	addDebug(request.ApiName, raw)
+	response, _ := raw.(*responses.CommonResponse)
+	return response, nil
+}
+
+// ListTagResources returns the tags of the resources with the given IDs, or the resources which have all of the given tags.
+func (s *TagService) ListTagResources(product TagProduct, resourceType TagResourceType, ids []string, tags map[string]interface{}) ([]TagResource, error) {
+	if len(tags) > TagResourcesMaxNumPerTime {
+		return nil, WrapError(fmt.Errorf("At most %d tags can be used to filter resources.", TagResourcesMaxNumPerTime))
+	}
+	var result []TagResource
+	invoker := NewInvoker(s.client)
+	for start := 0; start < len(ids) || start == 0; start += TagResourcesMaxNumPerTime {
+		end := start + TagResourcesMaxNumPerTime
+		if end > len(ids) {
+			end = len(ids)
+		}
+		request, err := s.newCommonRequest(product, "ListTagResources", resourceType)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		for i, id := range ids[start:end] {
+			request.QueryParams[fmt.Sprintf("ResourceId.%d", i+1)] = id
+		}
+		i := 1
+		for key, value := range tags {
+			request.QueryParams[fmt.Sprintf("Tag.%d.Key", i)] = key
+			request.QueryParams[fmt.Sprintf("Tag.%d.Value", i)] = value.(string)
+			i++
+		}
+
+		for {
+			var response *responses.CommonResponse
+			if err := invoker.Run(func() error {
+				response, err = s.processCommonRequest(product, request)
+				return err
+			}); err != nil {
+				return nil, WrapErrorf(err, DefaultErrorMsg, strings.Join(ids, ","), request.ApiName, AlibabaCloudSdkGoERROR)
+			}
+			var page listTagResourcesResponse
+			if err := json.Unmarshal(response.GetHttpContentBytes(), &page); err != nil {
+				return nil, WrapError(err)
+			}
+			result = append(result, page.TagResources.TagResource...)
+			if page.NextToken == "" {
+				break
+			}
+			request.QueryParams["NextToken"] = page.NextToken
+		}
+		if end >= len(ids) {
+			break
+		}
+	}
+	return result, nil
+}
+
+// DescribeTags returns the tags of a resource, excluding the ones added by Alibaba Cloud.
+func (s *TagService) DescribeTags(product TagProduct, resourceType TagResourceType, id string) (map[string]string, error) {
+	tagResources, err := s.ListTagResources(product, resourceType, []string{id}, nil)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	var tags []ecs.Tag
+	for _, t := range tagResources {
+		if t.ResourceId == id {
+			tags = append(tags, ecs.Tag{TagKey: t.TagKey, TagValue: t.TagValue})
+		}
+	}
+	return tagsToMap(tags), nil
+}
+
+// DescribeResourceIdsByTags returns the IDs of the resources which have all of the given tags.
+func (s *TagService) DescribeResourceIdsByTags(product TagProduct, resourceType TagResourceType, tags map[string]interface{}) (map[string]bool, error) {
+	tagResources, err := s.ListTagResources(product, resourceType, nil, tags)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	matched := make(map[string]int)
+	for _, t := range tagResources {
+		if value, ok := tags[t.TagKey]; ok && value.(string) == t.TagValue {
+			matched[t.ResourceId]++
+		}
+	}
+	ids := make(map[string]bool)
+	for id, count := range matched {
+		if count == len(tags) {
+			ids[id] = true
+		}
+	}
+	return ids, nil
+}
+
+// SetResourceTags applies the changes of "tags", together with the provider default_tags, to the resource.
+func (s *TagService) SetResourceTags(d *schema.ResourceData, product TagProduct, resourceType TagResourceType) error {
+	o, n, changed := tagsChange(s.client, d)
+	if !changed {
+		return nil
+	}
+	create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
+	invoker := NewInvoker(s.client)
+
+	// The tags whose values are changed are overwritten by TagResources, and only the removed keys are untagged
+	var removedKeys []string
+	for _, t := range remove {
+		if _, ok := n[t.Key]; !ok {
+			removedKeys = append(removedKeys, t.Key)
+		}
+	}
+	for start := 0; start < len(removedKeys); start += TagResourcesMaxNumPerTime {
+		end := start + TagResourcesMaxNumPerTime
+		if end > len(removedKeys) {
+			end = len(removedKeys)
+		}
+		log.Printf("[DEBUG] Removing tags: %#v from %s", removedKeys[start:end], d.Id())
+		request, err := s.newCommonRequest(product, "UntagResources", resourceType)
+		if err != nil {
+			return WrapError(err)
+		}
+		request.QueryParams["ResourceId.1"] = d.Id()
+		request.QueryParams["All"] = "false"
+		for i, key := range removedKeys[start:end] {
+			request.QueryParams[fmt.Sprintf("TagKey.%d", i+1)] = key
+		}
+		if err := invoker.Run(func() error {
+			_, err := s.processCommonRequest(product, request)
+			return err
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.ApiName, AlibabaCloudSdkGoERROR)
+		}
+	}
+
+	for start := 0; start < len(create); start += TagResourcesMaxNumPerTime {
+		end := start + TagResourcesMaxNumPerTime
+		if end > len(create) {
+			end = len(create)
+		}
+		log.Printf("[DEBUG] Creating tags: %s for %s", create[start:end], d.Id())
+		request, err := s.newCommonRequest(product, "TagResources", resourceType)
+		if err != nil {
+			return WrapError(err)
+		}
+		request.QueryParams["ResourceId.1"] = d.Id()
+		for i, t := range create[start:end] {
+			request.QueryParams[fmt.Sprintf("Tag.%d.Key", i+1)] = t.Key
+			request.QueryParams[fmt.Sprintf("Tag.%d.Value", i+1)] = t.Value
+		}
+		if err := invoker.Run(func() error {
+			_, err := s.processCommonRequest(product, request)
+			return err
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.ApiName, AlibabaCloudSdkGoERROR)
+		}
+	}
+
+	d.Set("tags_all", n)
+	d.SetPartial("tags")
+	d.SetPartial("tags_all")
+	return nil
+}
@@ -55,13 +55,13 @@ func (s *VpcService) DescribeNatGateway(id string) (nat vpc.NatGateway, err erro
 	request.RegionId = string(s.client.Region)
 	request.NatGatewayId = id
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeNatGateways(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, InvalidNatGatewayIdNotFound) {
+			if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 				return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
 			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -78,16 +78,20 @@ func (s *VpcService) DescribeNatGateway(id string) (nat vpc.NatGateway, err erro
 }
 
 func (s *VpcService) DescribeVpc(id string) (v vpc.DescribeVpcAttributeResponse, err error) {
+	if object, found, ok := batchDescribe(s.client, vpcDescribeKind, id); ok && found {
+		return object.(vpc.DescribeVpcAttributeResponse), nil
+	}
+
 	request := vpc.CreateDescribeVpcAttributeRequest()
 	request.VpcId = id
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeVpcAttribute(request)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{InvalidVpcIDNotFound, ForbiddenVpcNotFound}) {
+			if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 				return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
 			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -107,13 +111,13 @@ func (s *VpcService) DescribeVSwitch(id string) (v vpc.DescribeVSwitchAttributes
 	request := vpc.CreateDescribeVSwitchAttributesRequest()
 	request.VSwitchId = id
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeVSwitchAttributes(request)
 		})
 		if err != nil {
-			if IsExceptedError(err, InvalidVswitchIDNotFound) {
+			if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 				return WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
 			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -140,7 +144,7 @@ func (s *VpcService) DescribeSnatEntry(id string) (snat vpc.SnatTableEntry, err
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	for {
-		invoker := NewInvoker()
+		invoker := NewInvoker(s.client)
 		var response *vpc.DescribeSnatTableEntriesResponse
 		var raw interface{}
 		err = invoker.Run(func() error {
@@ -154,7 +158,7 @@ func (s *VpcService) DescribeSnatEntry(id string) (snat vpc.SnatTableEntry, err
 		//this special deal cause the DescribeSnatEntry can't find the records would be throw "cant find the snatTable error"
 		//so judge the snatEntries length priority
 		if err != nil {
-			if IsExceptedErrors(err, []string{InvalidSnatTableIdNotFound, InvalidSnatEntryIdNotFound}) {
+			if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 				return snat, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 			}
 			return snat, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -194,7 +198,7 @@ func (s *VpcService) DescribeForwardEntry(id string) (entry vpc.ForwardTableEntr
 	request.RegionId = string(s.client.Region)
 	request.ForwardTableId = forwardTableId
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeForwardTableEntries(request)
@@ -202,7 +206,7 @@ func (s *VpcService) DescribeForwardEntry(id string) (entry vpc.ForwardTableEntr
 		//this special deal cause the DescribeSnatEntry can't find the records would be throw "cant find the snatTable error"
 		//so judge the snatEntries length priority
 		if err != nil {
-			if IsExceptedErrors(err, []string{InvalidForwardEntryIdNotFound, InvalidForwardTableIdNotFound}) {
+			if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 				return WrapErrorf(Error(GetNotFoundMessage("ForwardEntry", id)), NotFoundMsg, ProviderERROR)
 			}
 			return WrapErrorf(err, DefaultErrorMsg, "ForwardEntry", request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -225,7 +229,7 @@ func (s *VpcService) QueryRouteTableById(routeTableId string) (rt vpc.RouteTable
 	request := vpc.CreateDescribeRouteTablesRequest()
 	request.RouteTableId = routeTableId
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeRouteTables(request)
@@ -256,7 +260,7 @@ func (s *VpcService) DescribeRouteEntry(id string) (v *vpc.RouteEntry, err error
 	request.RegionId = s.client.RegionId
 	request.RouteTableId = rtId
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	for {
 		var raw interface{}
 		if err := invoker.Run(func() error {
@@ -305,7 +309,7 @@ func (s *VpcService) DescribeRouterInterface(id, regionId string) (ri vpc.Router
 		},
 	}
 	request.Filter = &filter
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeRouterInterfaces(request)
@@ -355,7 +359,7 @@ func (s *VpcService) DescribeCenInstanceGrant(id string) (rule vpc.CbnGrantRule,
 	request.InstanceId = instanceId
 	request.InstanceType = instanceType
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeGrantRulesToCen(request)
@@ -406,7 +410,9 @@ func (s *VpcService) WaitForCenInstanceGrant(id string, status Status, timeout i
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.CenInstanceId, instanceId, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -414,7 +420,7 @@ func (s *VpcService) WaitForCenInstanceGrant(id string, status Status, timeout i
 func (s *VpcService) DescribeCommonBandwidthPackage(id string) (v vpc.CommonBandwidthPackage, err error) {
 	request := vpc.CreateDescribeCommonBandwidthPackagesRequest()
 	request.BandwidthPackageId = id
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeCommonBandwidthPackages(request)
@@ -461,7 +467,7 @@ func (s *VpcService) DescribeRouteTable(id string) (v vpc.RouterTableListType, e
 	request := vpc.CreateDescribeRouteTableListRequest()
 	request.RouteTableId = id
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	err = invoker.Run(func() error {
 		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeRouteTableList(request)
@@ -488,7 +494,7 @@ func (s *VpcService) DescribeRouteTableAttachment(id string) (v vpc.RouterTableL
 	if err != nil {
 		return v, WrapError(err)
 	}
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	routeTableId := parts[0]
 	vSwitchId := parts[1]
 
@@ -525,7 +531,9 @@ func (s *VpcService) WaitForVpc(id string, status Status, timeout int) error {
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -549,7 +557,9 @@ func (s *VpcService) WaitForVSwitch(id string, status Status, timeout int) error
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -572,7 +582,9 @@ func (s *VpcService) WaitForNatGateway(id string, status Status, timeout int) er
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -595,7 +607,9 @@ func (s *VpcService) WaitForRouteEntry(id string, status Status, timeout int) er
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, status, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -619,7 +633,9 @@ func (s *VpcService) WaitForAllRouteEntriesAvailable(routeTableId string, timeou
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, routeTableId, GetFunc(1), timeout, Available, Null, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -643,7 +659,9 @@ func (s *VpcService) WaitForRouterInterface(id, regionId string, status Status,
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -666,7 +684,9 @@ func (s *VpcService) WaitForRouterInterfaceConnection(id, regionId string, statu
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -689,7 +709,9 @@ func (s *VpcService) WaitForEip(id string, status Status, timeout int) error {
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -712,7 +734,9 @@ func (s *VpcService) WaitForEipAssociation(id string, status Status, timeout int
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -761,7 +785,9 @@ func (s *VpcService) WaitForForwardEntry(id string, status Status, timeout int)
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -871,7 +897,9 @@ func (s *VpcService) WaitForRouteTable(id string, status Status, timeout int) er
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(3 * time.Second)
+		if err := sleepContext(s.client, 3*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -896,7 +924,9 @@ func (s *VpcService) WaitForRouteTableAttachment(id string, status Status, timeo
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(3 * time.Second)
+		if err := sleepContext(s.client, 3*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -909,7 +939,7 @@ func (s *VpcService) DescribeNetworkAcl(id string) (networkAcl vpc.NetworkAcl, e
 		return vpcClient.DescribeNetworkAcls(request)
 	})
 	if err != nil {
-		if IsExceptedError(err, NetworkAclNotFound) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) {
 			return networkAcl, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return networkAcl, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -924,7 +954,7 @@ func (s *VpcService) DescribeNetworkAcl(id string) (networkAcl vpc.NetworkAcl, e
 
 func (s *VpcService) DescribeNetworkAclAttachment(id string, resource []vpc.Resource) (err error) {
 
-	invoker := NewInvoker()
+	invoker := NewInvoker(s.client)
 	return invoker.Run(func() error {
 		object, err := s.DescribeNetworkAcl(id)
 		if err != nil {
@@ -975,7 +1005,9 @@ func (s *VpcService) WaitForNetworkAcl(networkAclId string, status Status, timeo
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, networkAclId, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -1006,6 +1038,8 @@ func (s *VpcService) WaitForNetworkAclAttachment(id string, resource []vpc.Resou
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
@@ -24,7 +24,7 @@ func (s *VpnGatewayService) DescribeVpnGateway(id string) (v vpc.DescribeVpnGate
 		return vpcClient.DescribeVpnGateway(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{VpnForbidden, VpnNotFound}) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) || IsExceptedError(err, VpnForbidden) {
 			return v, WrapErrorf(Error(GetNotFoundMessage("VpnGateway", id)), NotFoundMsg, ProviderERROR)
 		}
 		return v, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -45,7 +45,7 @@ func (s *VpnGatewayService) DescribeVpnCustomerGateway(id string) (v vpc.Describ
 		return vpcClient.DescribeCustomerGateway(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{VpnForbidden, CgwNotFound}) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) || IsExceptedError(err, VpnForbidden) {
 			return v, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return v, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -66,7 +66,7 @@ func (s *VpnGatewayService) DescribeVpnConnection(id string) (v vpc.DescribeVpnC
 		return vpcClient.DescribeVpnConnection(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{VpnForbidden, VpnConnNotFound}) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) || IsExceptedError(err, VpnForbidden) {
 			return v, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return v, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -87,7 +87,7 @@ func (s *VpnGatewayService) DescribeSslVpnServer(id string) (v vpc.SslVpnServer,
 		return vpcClient.DescribeSslVpnServers(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{VpnForbidden, SslVpnServerNotFound}) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) || IsExceptedError(err, VpnForbidden) {
 			return v, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return v, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -109,7 +109,7 @@ func (s *VpnGatewayService) DescribeSslVpnClientCert(id string) (v vpc.DescribeS
 		return vpcClient.DescribeSslVpnClientCert(request)
 	})
 	if err != nil {
-		if IsExceptedErrors(err, []string{VpnForbidden, SslVpnClientCertNotFound}) {
+		if IsProductError(err, connectivity.VPCCode, ErrorNotFound) || IsExceptedError(err, VpnForbidden) {
 			return v, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
 		}
 		return v, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabaCloudSdkGoERROR)
@@ -141,7 +141,9 @@ func (s *VpnGatewayService) WaitForVpnGateway(id string, status Status, timeout
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -166,7 +168,9 @@ func (s *VpnGatewayService) WaitForVpnConnection(id string, status Status, timeo
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, Null, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -189,7 +193,9 @@ func (s *VpnGatewayService) WaitForVpnCustomerGateway(id string, status Status,
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, Null, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -212,7 +218,9 @@ func (s *VpnGatewayService) WaitForSslVpnServer(id string, status Status, timeou
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, Null, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -235,7 +243,9 @@ func (s *VpnGatewayService) WaitForSslVpnClientCert(id string, status Status, ti
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.client, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -36,12 +36,122 @@ func tagsSchemaComputed() *schema.Schema {
 	}
 }
 
+// tagsAllSchema returns the schema of all tags of a resource, including the provider default_tags.
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+	}
+}
+
+// setTagsAllDiff is a CustomizeDiffFunc which plans "tags_all" as the resource "tags" merged with the provider default_tags.
+func setTagsAllDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+	client := meta.(*connectivity.AliyunClient)
+	all := mergeDefaultTags(client, d.Get("tags").(map[string]interface{}))
+	if old, ok := d.Get("tags_all").(map[string]interface{}); ok && tagsEqual(old, all) {
+		return nil
+	}
+	return d.SetNew("tags_all", all)
+}
+
+// mergeDefaultTags merges the provider default_tags into the resource tags, and the resource tags take precedence.
+// The tags ignored by the provider are removed.
+func mergeDefaultTags(client *connectivity.AliyunClient, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range client.DefaultTags {
+		result[k] = v
+	}
+	for k, v := range tags {
+		result[k] = v
+	}
+	for k := range result {
+		if tagIgnoredByProvider(client, k) {
+			delete(result, k)
+		}
+	}
+	return result
+}
+
+// tagIgnoredByProvider checks whether a tag key is matched by the provider ignore_tags.
+func tagIgnoredByProvider(client *connectivity.AliyunClient, key string) bool {
+	for _, k := range client.IgnoreTagKeys {
+		if key == k {
+			return true
+		}
+	}
+	for _, prefix := range client.IgnoreTagKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// tagsChange returns the tags applied last time and the tags to apply, which include the provider default_tags.
+func tagsChange(client *connectivity.AliyunClient, d *schema.ResourceData) (map[string]interface{}, map[string]interface{}, bool) {
+	o := make(map[string]interface{})
+	oraw, _ := d.GetChange("tags_all")
+	for k, v := range oraw.(map[string]interface{}) {
+		if !tagIgnoredByProvider(client, k) {
+			o[k] = v
+		}
+	}
+	n := mergeDefaultTags(client, d.Get("tags").(map[string]interface{}))
+	return o, n, !tagsEqual(o, n)
+}
+
+// setResourceTags sets "tags_all" with the tags of the resource, and "tags" with those which do not come from the
+// provider default_tags. Tags ignored by the provider are skipped.
+func setResourceTags(client *connectivity.AliyunClient, d *schema.ResourceData, tags map[string]string) error {
+	all := make(map[string]string)
+	for k, v := range tags {
+		if !tagIgnoredByProvider(client, k) {
+			all[k] = v
+		}
+	}
+	if err := d.Set("tags_all", all); err != nil {
+		return WrapError(err)
+	}
+
+	configured := d.Get("tags").(map[string]interface{})
+	result := make(map[string]string)
+	for k, v := range all {
+		if value, ok := client.DefaultTags[k]; ok && value == v {
+			if _, ok := configured[k]; !ok {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	if err := d.Set("tags", result); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func tagsEqual(a, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if value, ok := b[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
+// tags field to be named "tags", and applies the provider default_tags as well.
 func setTags(client *connectivity.AliyunClient, resourceType TagResourceType, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		return updateTags(client, []string{d.Id()}, resourceType, oraw, nraw)
+	if o, n, changed := tagsChange(client, d); changed {
+		if err := updateTags(client, []string{d.Id()}, resourceType, o, n); err != nil {
+			return err
+		}
+		d.Set("tags_all", n)
 	}
 
 	return nil
@@ -258,3 +368,13 @@ func essTagIgnored(t ess.Tag) bool {
 	}
 	return false
 }
+
+// tagsContain reports whether the remote tags contain all of the expected tags.
+func tagsContain(tags map[string]string, expected map[string]interface{}) bool {
+	for key, value := range expected {
+		if v, ok := tags[key]; !ok || v != value.(string) {
+			return false
+		}
+	}
+	return true
+}
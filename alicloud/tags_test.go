@@ -2,6 +2,9 @@ package alicloud
 
 import (
 	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
 func TestTagsMapEqual(t *testing.T) {
@@ -48,3 +51,50 @@ func TestTagsMapEqual(t *testing.T) {
 		t.Fatal("Tag maps is equal.")
 	}
 }
+
+func TestMergeDefaultTags(t *testing.T) {
+	client := &connectivity.AliyunClient{
+		DefaultTags:          map[string]string{"Owner": "ops", "CostCenter": "1001"},
+		IgnoreTagKeys:        []string{"Temp"},
+		IgnoreTagKeyPrefixes: []string{"ack."},
+	}
+	tags := mergeDefaultTags(client, map[string]interface{}{"Owner": "dev", "Temp": "1", "ack.aliyun.com": "c1"})
+	expected := map[string]interface{}{"Owner": "dev", "CostCenter": "1001"}
+	if !tagsEqual(tags, expected) {
+		t.Fatalf("the merged tags are expected %v, got %v", expected, tags)
+	}
+}
+
+func TestSetResourceTags(t *testing.T) {
+	client := &connectivity.AliyunClient{
+		DefaultTags:          map[string]string{"Owner": "ops", "CostCenter": "1001"},
+		IgnoreTagKeyPrefixes: []string{"ack."},
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"tags":     tagsSchema(),
+		"tags_all": tagsAllSchema(),
+	}, map[string]interface{}{
+		"tags": map[string]interface{}{"Name": "tf", "CostCenter": "1001"},
+	})
+
+	err := setResourceTags(client, d, map[string]string{"Name": "tf", "Owner": "ops", "CostCenter": "1001", "ack.aliyun.com": "c1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tags := d.Get("tags").(map[string]interface{}); !tagsEqual(tags, map[string]interface{}{"Name": "tf", "CostCenter": "1001"}) {
+		t.Fatalf("the default tags which are not configured are expected to be skipped, got %v", tags)
+	}
+	if tags := d.Get("tags_all").(map[string]interface{}); !tagsEqual(tags, map[string]interface{}{"Name": "tf", "Owner": "ops", "CostCenter": "1001"}) {
+		t.Fatalf("the ignored tags are expected to be skipped, got %v", tags)
+	}
+}
+
+func TestTagsContain(t *testing.T) {
+	tags := map[string]string{"Name": "tf", "Owner": "ops"}
+	if !tagsContain(tags, map[string]interface{}{"Name": "tf"}) {
+		t.Fatalf("the tags are expected to contain Name=tf")
+	}
+	if tagsContain(tags, map[string]interface{}{"Name": "tf", "Owner": "dev"}) {
+		t.Fatalf("the tags are not expected to contain Owner=dev")
+	}
+}
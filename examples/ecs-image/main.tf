@@ -77,3 +77,15 @@ resource "alicloud_disk_attachment" "instance-attachment" {
   disk_id     = "${element(alicloud_disk.disk.*.id, count.index)}"
   instance_id = "${element(alicloud_instance.instance.*.id, count.index)}"
 }
+
+resource "alicloud_image" "image" {
+  count       = "${var.create_image ? 1 : 0}"
+  instance_id = "${alicloud_instance.instance.0.id}"
+  name        = "${var.short_name}-${var.role}-image"
+  description = "The image of the ${var.role} instance"
+
+  tags = {
+    role = "${var.role}"
+    dc   = "${var.datacenter}"
+  }
+}
@@ -13,3 +13,7 @@ output "ecs_public_ip" {
 output "tags" {
   value = "${jsonencode(alicloud_instance.instance.*.tags)}"
 }
+
+output "image_id" {
+  value = "${join(",", alicloud_image.image.*.id)}"
+}
@@ -57,3 +57,7 @@ variable "disk_category" {
 variable "disk_size" {
   default = "40"
 }
+
+variable "create_image" {
+  default = false
+}
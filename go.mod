@@ -39,7 +39,7 @@ require (
 	github.com/modern-go/reflect2 v1.0.1 // indirect
 	github.com/pkg/errors v0.8.1 // indirect
 	github.com/valyala/bytebufferpool v0.0.0-20180905182247-cdfbe9377474 // indirect
-	github.com/valyala/fasthttp v0.0.0-20180927122258-761788a34bb6 // indirect
+	github.com/valyala/fasthttp v0.0.0-20180927122258-761788a34bb6
 	go.opencensus.io v0.20.2 // indirect
 	golang.org/x/oauth2 v0.0.0-20190402181905-9f3314589c9a // indirect
 	google.golang.org/api v0.3.2 // indirect
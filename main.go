@@ -1,11 +1,22 @@
 package main
 
 import (
+	"os"
+
 	"github.com/hashicorp/terraform/plugin"
 	"github.com/terraform-providers/terraform-provider-alicloud/alicloud"
+	"github.com/terraform-providers/terraform-provider-alicloud/alicloud/connectivity"
 )
 
 func main() {
+	// Terraform runs the plugin without arguments, and the `discover` sub-command is run by the users directly.
+	if len(os.Args) > 1 && os.Args[1] == "discover" {
+		code := alicloud.RunDiscoverCommand(os.Args[2:], os.Stdout, os.Stderr)
+		connectivity.FlushMetricsReports()
+		os.Exit(code)
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: alicloud.Provider})
+	connectivity.FlushMetricsReports()
 }